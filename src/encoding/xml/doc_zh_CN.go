@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -46,6 +47,17 @@ var HTMLAutoClose = htmlAutoClose
 // HTMLEntity是标准HTML entity字符到其翻译的映射。
 var HTMLEntity = htmlEntity
 
+// NoTimeZone is the *time.Location, with zero offset, of a time.Time decoded
+// from an xs:date, xs:time or xs:dateTime value that has no time zone
+// suffix. Marshal writes a time in NoTimeZone without a suffix, so such
+// values round-trip unchanged. It is distinct from time.UTC, which is
+// written with a "Z" suffix.
+
+// NoTimeZone是从不带时区后缀的xs:date、xs:time或xs:dateTime值解码得到的time.Time
+// 所使用的*time.Location，其偏移量为零。Marshal写出位于NoTimeZone中的时间时不带后
+// 缀，因此此类值可以原样往返。它不同于time.UTC，后者会带"Z"后缀写出。
+var NoTimeZone = time.FixedZone("", 0)
+
 // An Attr represents an attribute in an XML element (Name=Value).
 
 // Attr代表一个XML元素的一条属性（Name=Value）
@@ -54,6 +66,44 @@ type Attr struct {
 	Value string
 }
 
+// AttrAwareMarshaler is the interface implemented by values, typically named
+// scalar types, that carry annotations such as a unit alongside their value.
+//
+// MarshalXMLValue returns the character data and the attributes of the
+// element holding the receiver. The element name is chosen as for any other
+// value; the returned attributes are added after those from the struct.
+
+// 实现了AttrAwareMarshaler接口的值（通常是具名的标量类型）在其值之外还带有注解，例
+// 如单位。
+//
+// MarshalXMLValue返回持有方法调用者的元素的字符数据和属性。元素名称的选择与其他值相
+// 同；返回的属性会添加在来自结构体的属性之后。
+type AttrAwareMarshaler interface {
+	MarshalXMLValue() (chardata []byte, attr []Attr, err error)
+}
+
+// AttrAwareUnmarshaler is the interface implemented by values that decode
+// themselves from both the character data and the attributes of an element.
+//
+// UnmarshalXMLValue receives the concatenated character data of the element
+// and all of its attributes. The slices must not be retained after the call.
+
+// 实现了AttrAwareUnmarshaler接口的值可以同时根据元素的字符数据和属性反序列化自身。
+//
+// UnmarshalXMLValue接收元素拼接后的字符数据及其所有属性。调用返回后不能保留这些切片
+// 。
+type AttrAwareUnmarshaler interface {
+	UnmarshalXMLValue(chardata []byte, attr []Attr) error
+}
+
+// A CDATA represents character data to be written by EncodeToken as one
+// or more <![CDATA[ ... ]]> sections, without escaping. The decoder never
+// returns CDATA; CDATA sections in the input are reported as CharData.
+
+// CDATA代表由EncodeToken写为一个或多个<![CDATA[ ... ]]>节、且不进行转义的字符数
+// 据。解码器从不返回CDATA；输入中的CDATA节会被报告为CharData。
+type CDATA []byte
+
 // A CharData represents XML character data (raw text),
 // in which XML escape sequences have been replaced by
 // the characters they represent.
@@ -68,6 +118,19 @@ type CharData []byte
 // Comment代表XML注释，格式为<!--comment-->，切片中不包含注释标记<!—和-->。
 type Comment []byte
 
+// ConditionalMarshaler is the interface implemented by objects that decide
+// for themselves whether they are marshaled. If ShouldMarshalXML returns
+// false, Marshal omits the value entirely, whether it would be written as an
+// element or as an attribute. The method is consulted before MarshalXML and
+// the "omitempty" option.
+
+// 实现了ConditionalMarshaler接口的类型可以自行决定是否被序列化。如果
+// ShouldMarshalXML返回false，Marshal会完全忽略该值，无论它原本会被写为元素还是属
+// 性。该方法在MarshalXML和"omitempty"选项之前被检查。
+type ConditionalMarshaler interface {
+	ShouldMarshalXML() bool
+}
+
 // A Decoder represents an XML parser reading a particular input stream.
 // The parser assumes that its input is encoded in UTF-8.
 
@@ -90,7 +153,8 @@ type Decoder struct {
 	// 	d.AutoClose = HTMLAutoClose;
 	// 	d.Entity = HTMLEntity
 	//
-	// creates a parser that can handle typical HTML.
+	// creates a parser that can handle typical HTML. NewHTMLDecoder returns
+	// a Decoder configured this way.
 	//
 	// Strict mode does not enforce the requirements of the XML name spaces TR.
 	// In particular it does not reject name space tags using undefined
@@ -114,6 +178,15 @@ type Decoder struct {
 	// 	"quot": `"`,
 	Entity map[string]string
 
+	// EntityExpansionLimit bounds the total number of bytes produced by
+	// replacing entity references, including those defined in Entity,
+	// during a single call to Decode or DecodeElement, or a single call to
+	// Token or RawToken otherwise. Exceeding it returns a *SyntaxError.
+	// Zero means no limit. Decoders reading untrusted input with a
+	// non-empty Entity map should set a limit; 1<<20 is a reasonable
+	// choice for most documents.
+	EntityExpansionLimit int
+
 	// CharsetReader, if non-nil, defines a function to generate
 	// charset-conversion readers, converting from the provided
 	// non-UTF-8 charset into UTF-8. If CharsetReader is nil or
@@ -125,6 +198,66 @@ type Decoder struct {
 	// as if the entire XML stream were wrapped in an element containing
 	// the attribute xmlns="DefaultSpace".
 	DefaultSpace string
+
+	// DisallowUnknownElements causes Decode and DecodeElement to return an
+	// error when an element does not map to any field of the destination
+	// struct and the struct has no ",any" field. The error names the first
+	// such element and its path from the start element.
+	DisallowUnknownElements bool
+
+	// OnFieldMatch, if non-nil, is called by Decode and DecodeElement each
+	// time an element is mapped to a struct field, with the element's name,
+	// the name of the chosen field and the name of the struct type. It is
+	// intended for tracing how the Unmarshal precedence rules were applied.
+	OnFieldMatch func(elem Name, chosenField, structType string)
+
+	// MaxAttributes limits the number of attributes a single start element
+	// may have. If the limit is exceeded while the start tag is read, Token
+	// returns a *LimitError. Zero means no limit.
+	MaxAttributes int
+
+	// MaxTokenBytes limits the number of bytes the decoder accumulates for
+	// a single token, such as a run of character data, a CDATA section, a
+	// comment or an attribute value. It is checked as the internal buffer
+	// grows, and Token returns a *LimitError once it is exceeded. Zero
+	// means no limit.
+	MaxTokenBytes int
+
+	// MaxDepth limits how deeply elements may be nested. It is checked by
+	// Token and RawToken as each start element is read, in both strict and
+	// non-strict mode, and so also applies to Decode and DecodeElement.
+	// Exceeding it returns a *SyntaxError reporting the current line. Zero
+	// means no limit.
+	MaxDepth int
+
+	// NumberParser, if non-nil, replaces the conversion of character data
+	// and attribute values to integer and floating-point fields during
+	// Decode and DecodeElement. It is called with the text, the bit size of
+	// the field and its kind; only the result matching the kind (int64 for
+	// signed integers, uint64 for unsigned integers, float64 for floats) is
+	// used. A non-nil error aborts decoding.
+	NumberParser func(s string, bitSize int, kind reflect.Kind) (int64, uint64, float64, error)
+
+	// OnComment, if non-nil, is called with every comment the decoder
+	// reads, whether through Token, RawToken, Decode or DecodeElement, and
+	// regardless of whether the comment is also stored in a ",comment"
+	// field. The Comment is only valid during the call. A non-nil error
+	// stops decoding and is returned by the current call.
+	OnComment func(Comment) error
+
+	// Intern causes the decoder to share the memory of repeated strings:
+	// name spaces and local names of elements and attributes, attribute
+	// values, and strings of up to 64 bytes stored by Decode, are looked up
+	// in a table kept for the life of the Decoder. This reduces memory use
+	// for large documents with few distinct names and values, at the cost
+	// of a map lookup per string and the memory held by the table.
+	Intern bool
+
+	// StrictEmpty causes Decode and DecodeElement to return an error when
+	// an empty element or attribute value is mapped to a bool, integer or
+	// floating-point field. By default such a field is left at its zero
+	// value.
+	StrictEmpty bool
 }
 
 // A Directive represents an XML directive of the form <!text>.
@@ -133,10 +266,86 @@ type Decoder struct {
 // Directive代表XML指示，格式为<!directive>，切片中不包含标记<!和>。
 type Directive []byte
 
+// A Doctype holds the parts of a <!DOCTYPE ...> directive.
+
+// Doctype保存了<!DOCTYPE ...>指示的各个组成部分。
+type Doctype struct {
+	Name     string // The root element name.
+	PublicID string // The public identifier, if any.
+	SystemID string // The system identifier, if any.
+
+	// InternalSubset reports whether the directive contains an internal
+	// subset enclosed in square brackets.
+	InternalSubset bool
+}
+
+// A Document is a full-fidelity parse tree of an XML document. Together with
+// its Nodes it retains everything needed to reproduce the input byte for
+// byte: the declaration, comments, processing instructions, whitespace,
+// attribute order, quoting and entity spellings.
+
+// Document是XML文档的完全保真解析树。它与其包含的Node一起保留了逐字节重现输入所需的
+// 一切：声明、注释、处理指令、空白、属性顺序、引号和实体的写法。
+type Document struct {
+	Children []*Node // The top-level nodes, in document order.
+}
+
 // An Encoder writes XML data to an output stream.
 
 // Encoder向输出流中写入XML数据。
 type Encoder struct {
+	// ASCIIOnly causes every rune above U+007F in character data and
+	// attribute values to be written as a hexadecimal numeric character
+	// reference such as &#x1F600;, so that the output is pure ASCII. Runes
+	// outside the Basic Multilingual Plane are written as a single
+	// reference, never as a surrogate pair. The encoder does not write or
+	// alter any XML declaration; a declaration passed to EncodeToken is
+	// written as given.
+	ASCIIOnly bool
+
+	// DefaultSpace sets the default name space of the output. It is
+	// declared with an xmlns attribute on the first element written, and
+	// elements whose Name has an empty Space are placed in it. It is the
+	// counterpart of Decoder.DefaultSpace. Independently of DefaultSpace, a
+	// child element with no name space of its own inherits the default
+	// name space declared by its parent.
+	DefaultSpace string
+
+	// WriteBOM causes the encoder to write the UTF-8 byte order mark
+	// (EF BB BF) once, before anything else, including an XML declaration
+	// written with EncodeToken.
+	WriteBOM bool
+
+	// DeclareNamespacesAtRoot causes Encode and EncodeElement to declare
+	// every name space used anywhere in the value on the root element, with
+	// prefixes assigned in order of first use, instead of on the elements
+	// where they are first needed. The encoder must then buffer the whole
+	// value before writing the root element.
+	DeclareNamespacesAtRoot bool
+
+	// Escape, if non-nil, overrides the references written for the five
+	// predefined entities in character data and attribute values.
+	Escape *EscapeOptions
+
+	// UseStringer causes a value that implements fmt.Stringer, but none of
+	// Marshaler, MarshalerAttr, AttrAwareMarshaler or
+	// encoding.TextMarshaler, to be written as the result of its String
+	// method, as character data or as an attribute value. Those interfaces
+	// take precedence over String when both are implemented. A value that
+	// also implements ConditionalMarshaler is still omitted when
+	// ShouldMarshalXML returns false; String is only called for values
+	// that are written. This only affects encoding: Unmarshal cannot
+	// reverse String and decodes such values by their underlying kind as
+	// usual.
+	UseStringer bool
+
+	// NameFunc, if non-nil, is called for each struct field whose tag does
+	// not give an explicit name, to derive the name of the element or
+	// attribute written for it. It is consulted for attributes and for the
+	// fields of nested structs alike. If it returns the empty string, the
+	// field name is used as usual. NameFunc is not consulted for XMLName
+	// fields or for fields with a tag name.
+	NameFunc func(reflect.StructField) string
 }
 
 // An EndElement represents an XML end element.
@@ -146,6 +355,32 @@ type EndElement struct {
 	Name Name
 }
 
+// EscapeOptions sets the text an Encoder writes in place of each character
+// that has a predefined entity. An empty field keeps the default reference.
+// For example, setting Apos to "&#39;" avoids &apos;, which HTML does not
+// recognize. Each replacement must be a valid reference to the character.
+
+// EscapeOptions设置Encoder用来替换每个具有预定义实体的字符的文本。字段为空时保持默认
+// 的引用。例如，将Apos设为"&#39;"可以避免使用HTML无法识别的&apos;。每个替换文本都
+// 必须是对该字符的合法引用。
+type EscapeOptions struct {
+	Lt   string // Replacement for '<'; default "&lt;".
+	Gt   string // Replacement for '>'; default "&gt;".
+	Amp  string // Replacement for '&'; default "&amp;".
+	Apos string // Replacement for '\''; default "&apos;".
+	Quot string // Replacement for '"'; default "&quot;".
+}
+
+// A LimitError is returned when the input exceeds one of the limits that can
+// be configured on a Decoder.
+
+// 当输入超出了Decoder上配置的某项限制时，会返回LimitError。
+type LimitError struct {
+	Limit string // The name of the exceeded Decoder field, such as "MaxAttributes".
+	Max   int    // The configured limit.
+	Line  int    // The line of the input at which the limit was exceeded.
+}
+
 // Marshaler is the interface implemented by objects that can marshal
 // themselves into valid XML elements.
 //
@@ -207,6 +442,43 @@ type Name struct {
 	Space, Local string
 }
 
+// A Node is a node of a Document.
+//
+// Token holds the node's token, copied so that it remains valid: a
+// StartElement for an element, or a CharData, Comment, ProcInst or
+// Directive. Raw holds the node's original text; for an element this is
+// just its start tag. When a node is written, its Raw text is used if it is
+// non-nil and Token is encoded otherwise, so setting Raw to nil after
+// editing Token re-serializes only that node.
+
+// Node是Document中的一个节点。
+//
+// Token保存该节点的token，且已被复制以保证其一直有效：元素的token是StartElement，
+// 其他节点则是CharData、Comment、ProcInst或Directive。Raw保存节点的原始文本；对于
+// 元素来说仅是其起始标签。写出节点时，如果Raw不为nil则使用Raw，否则编码Token，因此
+// 在修改Token后将Raw置为nil，只会重新序列化该节点。
+type Node struct {
+	Token Token
+	Raw   []byte
+
+	// EndRaw holds the original text of an element's end tag. It is nil
+	// for a self-closing element and for nodes that are not elements.
+	EndRaw []byte
+
+	// Children holds the child nodes of an element, in document order.
+	Children []*Node
+}
+
+// An Occurs describes how many times a child element may appear in its
+// parent, as part of a Schema.
+
+// Occurs描述了在Schema中一个子元素可以在其父元素中出现的次数。
+type Occurs struct {
+	Name Name
+	Min  int // Minimum number of occurrences.
+	Max  int // Maximum number of occurrences; negative means unbounded.
+}
+
 // A ProcInst represents an XML processing instruction of the form <?target
 // inst?>
 
@@ -216,6 +488,32 @@ type ProcInst struct {
 	Inst   []byte
 }
 
+// A RawElement holds a complete, well-formed XML element, such as the output
+// of a separate call to Marshal. A struct field of type RawElement is written
+// verbatim as content of the enclosing element, without being parsed.
+
+// RawElement保存一个完整的、格式良好的XML元素，例如单独调用Marshal得到的输出。类
+// 型为RawElement的结构体字段会原样写入外层元素的内容中，而不会被解析。
+type RawElement []byte
+
+// A Schema is a lightweight description of the structure of a document: the
+// name of its root element and, for each element, the children it may
+// contain and how often. It is checked while decoding; see Decoder.Validate.
+// A Name with an empty Space matches that local name in any name space.
+
+// Schema是对文档结构的轻量级描述：包括根元素的名称，以及每个元素可以包含哪些子元素
+// 和各自出现的次数。它在解码过程中被检查，参见Decoder.Validate。Space为空的Name可
+// 以匹配任意名字空间中的同名元素。
+type Schema struct {
+	// Root is the name of the document element.
+	Root Name
+
+	// Children maps an element name to the child elements it may contain,
+	// in any order. An element that is not in the map may contain any
+	// children.
+	Children map[Name][]Occurs
+}
+
 // A StartElement represents an XML start element.
 
 // StartElement代表一个XML起始元素。
@@ -230,6 +528,10 @@ type StartElement struct {
 type SyntaxError struct {
 	Msg  string
 	Line int
+
+	// Column is the 1-based byte offset of the error within Line, or 0 if
+	// it is not known. Error renders it as "line:column: msg" when set.
+	Column int
 }
 
 // A TagPathError represents an error in the unmarshalling process
@@ -243,10 +545,11 @@ type TagPathError struct {
 }
 
 // A Token is an interface holding one of the token types:
-// StartElement, EndElement, CharData, Comment, ProcInst, or Directive.
+// StartElement, EndElement, CharData, CDATA, Comment, ProcInst, or Directive.
+// CDATA is only accepted by EncodeToken.
 
-// Token接口用于保存token类型（CharData、Comment、Directive、ProcInst、
-// StartElement、EndElement）的值。
+// Token接口用于保存token类型（CharData、CDATA、Comment、Directive、ProcInst、
+// StartElement、EndElement）的值。CDATA只被EncodeToken接受。
 type Token interface {
 }
 
@@ -309,8 +612,13 @@ type UnsupportedTypeError struct {
 }
 
 // CopyToken returns a copy of a Token.
+// The copy shares no memory with the decoder: byte slices are copied, and
+// for a StartElement the Attr slice is copied as by StartElement.Copy, so
+// the copy remains valid and unchanged after later calls to Token.
 
 // CopyToken返回一个Token的拷贝。
+// 该拷贝不与解码器共享任何内存：字节切片会被复制，对于StartElement，其Attr切片会像
+// StartElement.Copy那样被复制，因此在之后调用Token后，该拷贝仍然有效且保持不变。
 func CopyToken(t Token) Token
 
 // Escape is like EscapeText but omits the error return value.
@@ -321,6 +629,19 @@ func CopyToken(t Token) Token
 // 1.0的向后兼容。应用于Go 1.1及以后版本的代码请使用EscapeText。
 func Escape(w io.Writer, s []byte)
 
+// EscapeAttrValue writes to w the properly escaped XML equivalent of s for
+// use as an attribute value delimited by quote, which must be '"' or '\''.
+// In addition to the characters escaped by EscapeText, it escapes the quote
+// character in use, and writes tab, newline and carriage return as character
+// references so that they survive attribute-value normalization. It returns
+// an error if quote is not a valid delimiter.
+
+// EscapeAttrValue向w中写入经过适当转义的、与s具有相同意义的XML文本，用作以quote
+// 分隔的属性值，quote必须为'"'或'\''。除了EscapeText会转义的字符之外，它还会转义正
+// 在使用的引号字符，并将制表符、换行符和回车符写为字符引用，使它们在属性值规范化后
+// 得以保留。若quote不是合法的分隔符，则返回错误。
+func EscapeAttrValue(w io.Writer, s []byte, quote byte) error
+
 // EscapeText writes to w the properly escaped XML equivalent
 // of the plain text data s.
 
@@ -336,6 +657,16 @@ func EscapeText(w io.Writer, s []byte) error
 // writing nothing. Marshal handles all other data by writing one or more XML
 // elements containing the data.
 //
+// Marshal handles a value implementing encoding.TextMarshaler, such as a
+// *big.Int or *big.Rat, by writing the result of its MarshalText method as
+// the character data of the element or, for a field with the "attr" option,
+// as the attribute value. A nil pointer of such a type is empty for the
+// purposes of "omitempty".
+// Elements and attributes are handled alike, so an enumerated type such as
+// a named integer with MarshalText can be used in either position. A String
+// method alone is not consulted unless Encoder.UseStringer is set; otherwise
+// such a type must implement encoding.TextMarshaler to control its XML form.
+//
 // The name for the XML elements is taken from, in order of preference:
 //
 // 	- the tag on the XMLName field, if the data is a struct
@@ -353,25 +684,66 @@ func EscapeText(w io.Writer, s []byte) error
 // 	  the given name in the XML element.
 // 	- a field with tag ",attr" becomes an attribute with the
 // 	  field name in the XML element.
+// 	- a slice field with the "list" option in addition to "attr"
+// 	  becomes a single attribute holding its entries separated by
+// 	  spaces, such as "classes,attr,list". The option "list=sep" uses
+// 	  the separator sep instead, such as "ids,attr,list=;". Because
+// 	  tag options are split on commas, sep cannot contain a comma;
+// 	  "list=comma" selects a comma separator. An empty sep, as in
+// 	  "list=", is the same as "list".
+// 	- a field with a tag option of the form "attr:name=value", such as
+// 	  "temperature,attr:unit=celsius", is written as an element holding
+// 	  the field value, carrying the constant attribute name="value"
+// 	  taken from the tag. The option may be repeated to attach several
+// 	  constant attributes.
 // 	- a field with tag ",chardata" is written as character data,
 // 	  not as an XML element.
 // 	- a field with tag ",cdata" is written as character data
 // 	  wrapped in one or more <![CDATA[ ... ]]> tags, not as an XML element.
 // 	- a field with tag ",innerxml" is written verbatim, not subject
 // 	  to the usual marshalling procedure.
+// 	- a field of type RawElement is written verbatim as a child of the
+// 	  element, after the element's start tag has been closed.
 // 	- a field with tag ",comment" is written as an XML comment, not
 // 	  subject to the usual marshalling procedure. It must not contain
 // 	  the "--" string within it.
+// 	- a field with tag ",order" is omitted; it is only used by Unmarshal.
+// 	- a field with tag ",rawattr" is omitted; it is only used by
+// 	  Unmarshal.
+// 	- a field with a ",select=" tag option is omitted; it is only used
+// 	  by Unmarshal.
+// 	- a bool field with tag "name,presence" is written as an empty
+// 	  element with the given name when true and omitted when false.
 // 	- a field with a tag including the "omitempty" option is omitted
 // 	  if the field value is empty. The empty values are false, 0, any
 // 	  nil pointer or interface value, and any array, slice, map, or
 // 	  string of length zero.
+// 	- a field whose value implements ConditionalMarshaler and whose
+// 	  ShouldMarshalXML method returns false is omitted, producing neither
+// 	  an element nor an attribute. The check applies to struct values,
+// 	  pointers, and each element of a slice or array alike.
+// 	- a time.Time field with tag ",date", ",time" or ",datetime" is
+// 	  written using the XSD xs:date, xs:time or xs:dateTime layout
+// 	  respectively. A UTC value is written with a "Z" suffix, a value
+// 	  in NoTimeZone is written with no suffix, and any other location
+// 	  is written as a numeric "+hh:mm" offset.
 // 	- an anonymous struct field is handled as if the fields of its
 // 	  value were part of the outer struct.
+// 	- an anonymous field of a named non-struct type is handled as
+// 	  follows: a scalar (string, []byte, bool or numeric) field is
+// 	  written as character data of the outer element, as if tagged
+// 	  ",chardata"; a slice field is written as one element per entry,
+// 	  named after the field's type; a map field is an error.
 //
 // If a field uses a tag "a>b>c", then the element c will be nested inside
 // parent elements a and b. Fields that appear next to each other that name the
 // same parent will be enclosed in one XML element.
+// For a slice field tagged "a>b", each entry is written as a b element and
+// all of them are enclosed in a single a element. An empty or nil slice is
+// written as an empty a element, unless the field has the "omitempty"
+// option, in which case the wrapper is omitted as well.
+// Likewise, a slice-of-slices field tagged "a>b>c" is written as one b
+// element per inner slice, each holding a c element per entry.
 //
 // See MarshalIndent for an example.
 //
@@ -386,6 +758,16 @@ func EscapeText(w io.Writer, s []byte) error
 // writing nothing. Marshal handles all other data by writing one or more XML
 // elements containing the data.
 //
+// Marshal handles a value implementing encoding.TextMarshaler, such as a
+// *big.Int or *big.Rat, by writing the result of its MarshalText method as
+// the character data of the element or, for a field with the "attr" option,
+// as the attribute value. A nil pointer of such a type is empty for the
+// purposes of "omitempty".
+// Elements and attributes are handled alike, so an enumerated type such as
+// a named integer with MarshalText can be used in either position. A String
+// method alone is not consulted unless Encoder.UseStringer is set; otherwise
+// such a type must implement encoding.TextMarshaler to control its XML form.
+//
 // The name for the XML elements is taken from, in order of preference:
 //
 // 	- the tag on the XMLName field, if the data is a struct
@@ -403,25 +785,66 @@ func EscapeText(w io.Writer, s []byte) error
 // 	  the given name in the XML element.
 // 	- a field with tag ",attr" becomes an attribute with the
 // 	  field name in the XML element.
+// 	- a slice field with the "list" option in addition to "attr"
+// 	  becomes a single attribute holding its entries separated by
+// 	  spaces, such as "classes,attr,list". The option "list=sep" uses
+// 	  the separator sep instead, such as "ids,attr,list=;". Because
+// 	  tag options are split on commas, sep cannot contain a comma;
+// 	  "list=comma" selects a comma separator. An empty sep, as in
+// 	  "list=", is the same as "list".
+// 	- a field with a tag option of the form "attr:name=value", such as
+// 	  "temperature,attr:unit=celsius", is written as an element holding
+// 	  the field value, carrying the constant attribute name="value"
+// 	  taken from the tag. The option may be repeated to attach several
+// 	  constant attributes.
 // 	- a field with tag ",chardata" is written as character data,
 // 	  not as an XML element.
 // 	- a field with tag ",cdata" is written as character data
 // 	  wrapped in one or more <![CDATA[ ... ]]> tags, not as an XML element.
 // 	- a field with tag ",innerxml" is written verbatim, not subject
 // 	  to the usual marshalling procedure.
+// 	- a field of type RawElement is written verbatim as a child of the
+// 	  element, after the element's start tag has been closed.
 // 	- a field with tag ",comment" is written as an XML comment, not
 // 	  subject to the usual marshalling procedure. It must not contain
 // 	  the "--" string within it.
+// 	- a field with tag ",order" is omitted; it is only used by Unmarshal.
+// 	- a field with tag ",rawattr" is omitted; it is only used by
+// 	  Unmarshal.
+// 	- a field with a ",select=" tag option is omitted; it is only used
+// 	  by Unmarshal.
+// 	- a bool field with tag "name,presence" is written as an empty
+// 	  element with the given name when true and omitted when false.
 // 	- a field with a tag including the "omitempty" option is omitted
 // 	  if the field value is empty. The empty values are false, 0, any
 // 	  nil pointer or interface value, and any array, slice, map, or
 // 	  string of length zero.
+// 	- a field whose value implements ConditionalMarshaler and whose
+// 	  ShouldMarshalXML method returns false is omitted, producing neither
+// 	  an element nor an attribute. The check applies to struct values,
+// 	  pointers, and each element of a slice or array alike.
+// 	- a time.Time field with tag ",date", ",time" or ",datetime" is
+// 	  written using the XSD xs:date, xs:time or xs:dateTime layout
+// 	  respectively. A UTC value is written with a "Z" suffix, a value
+// 	  in NoTimeZone is written with no suffix, and any other location
+// 	  is written as a numeric "+hh:mm" offset.
 // 	- an anonymous struct field is handled as if the fields of its
 // 	  value were part of the outer struct.
+// 	- an anonymous field of a named non-struct type is handled as
+// 	  follows: a scalar (string, []byte, bool or numeric) field is
+// 	  written as character data of the outer element, as if tagged
+// 	  ",chardata"; a slice field is written as one element per entry,
+// 	  named after the field's type; a map field is an error.
 //
 // If a field uses a tag "a>b>c", then the element c will be nested inside
 // parent elements a and b. Fields that appear next to each other that name the
 // same parent will be enclosed in one XML element.
+// For a slice field tagged "a>b", each entry is written as a b element and
+// all of them are enclosed in a single a element. An empty or nil slice is
+// written as an empty a element, unless the field has the "omitempty"
+// option, in which case the wrapper is omitted as well.
+// Likewise, a slice-of-slices field tagged "a>b>c" is written as one b
+// element per inner slice, each holding a c element per entry.
 //
 // See MarshalIndent for an example.
 //
@@ -431,22 +854,78 @@ func Marshal(v interface{}) ([]byte, error)
 // MarshalIndent works like Marshal, but each XML element begins on a new
 // indented line that starts with prefix and is followed by one or more
 // copies of indent according to the nesting depth.
+// An element that has both character data and child elements is mixed
+// content, in which whitespace is significant, so it and everything inside
+// it are written without indentation.
 
 // MarshalIndent功能类似Marshal。但每个XML元素会另起一行并缩进，该行以prefix起始
 // ，后跟一或多个indent的拷贝（根据嵌套层数）。
+// 同时包含字符数据和子元素的元素属于混合内容，其中的空白是有意义的，因此该元素及其
+// 内部的所有内容都不会缩进。
 func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error)
 
+// MarshalOrderedMap writes to enc an element with the given name containing
+// one child element per key in keys, in that order, named after the key and
+// holding m[key] as character data. It returns an error if a key is not in m
+// or is not a valid element name. Entries of m not listed in keys are not
+// written.
+
+// MarshalOrderedMap向enc写入一个具有给定名称的元素，其中按keys的顺序为每个键包含一
+// 个子元素，子元素以键命名，并以m[key]作为字符数据。如果某个键不在m中或者不是合法的
+// 元素名，会返回一个错误。m中未在keys里列出的条目不会被写入。
+func MarshalOrderedMap(enc *Encoder, name Name, keys []string, m map[string]string) error
+
+// MarshalTo appends the XML encoding of v to buf. It produces exactly the
+// output of Marshal, but lets the caller reuse buf across calls instead of
+// allocating a new result each time. On error, buf may hold partial output.
+
+// MarshalTo将v的XML编码追加到buf中。它生成的输出与Marshal完全相同，但允许调用者在多
+// 次调用之间重用buf，而不必每次都分配新的结果。出错时，buf中可能包含部分输出。
+func MarshalTo(buf *bytes.Buffer, v interface{}) error
+
 // NewDecoder creates a new XML parser reading from r.
 // If r does not implement io.ByteReader, NewDecoder will
 // do its own buffering.
+//
+// A UTF-8 byte order mark at the start of the input is skipped only when no
+// encoding other than UTF-8 is declared, so Unmarshal and Decode accept
+// documents written by tools that add one. If the XML declaration names
+// another encoding, the mark is instead passed to CharsetReader with the
+// rest of the input.
 
 // 创建一个从r读取XML数据的解析器。如果r未实现io.ByteReader接口，NewDecoder会为
 // 其添加缓存。
+//
+// 仅当未声明UTF-8以外的编码时，输入开头的UTF-8字节顺序标记才会被跳过，因此
+// Unmarshal和Decode可以接受由会添加该标记的工具写出的文档。如果XML声明指定了其他编
+// 码，该标记会与其余输入一起交给CharsetReader处理。
 func NewDecoder(r io.Reader) *Decoder
 
 // NewEncoder returns a new encoder that writes to w.
 func NewEncoder(w io.Writer) *Encoder
 
+// NewHTMLDecoder creates a new XML parser reading from r that is configured
+// to handle typical HTML: Strict is false, AutoClose is HTMLAutoClose and
+// Entity is HTMLEntity, so unknown or malformed entities are left alone.
+
+// NewHTMLDecoder创建一个从r读取数据、并已配置为可以处理典型HTML的XML解析器：
+// Strict为false，AutoClose为HTMLAutoClose，Entity为HTMLEntity，因此未知或格式错误
+// 的实体会保持原样。
+func NewHTMLDecoder(r io.Reader) *Decoder
+
+// Parse reads an XML document from r and returns its full-fidelity parse
+// tree. The input must be well-formed.
+
+// Parse从r读取XML文档并返回其完全保真的解析树。输入必须是格式良好的。
+func Parse(r io.Reader) (*Document, error)
+
+// ParseDoctype parses the DOCTYPE directive d, as returned by Decoder.Token.
+// It returns an error if d is not a DOCTYPE declaration or is malformed.
+
+// ParseDoctype解析Decoder.Token返回的DOCTYPE指示d。如果d不是DOCTYPE声明或者格式
+// 错误，会返回一个错误。
+func ParseDoctype(d Directive) (*Doctype, error)
+
 // Unmarshal parses the XML-encoded data and stores the result in
 // the value pointed to by v, which must be an arbitrary struct,
 // slice, or string. Well-formed data that does not fit into v is
@@ -478,6 +957,24 @@ func NewEncoder(w io.Writer) *Encoder
 //      the explicit name in a struct field tag of the form "name,attr",
 //      Unmarshal records the attribute value in that field.
 //
+//   * If the attribute's field is a slice with the "list" option, such
+//      as "class,attr,list", Unmarshal splits the attribute value on
+//      white space, or on the separator given as "list=sep" ("list=comma"
+//      for a comma, "list=" for white space), and records each part,
+//      converted to the element type of the slice, as an entry. An empty
+//      attribute value yields an empty, non-nil slice.
+//
+//   * If a field's tag includes an option of the form "attr:name=value",
+//      such as "temperature,attr:unit=celsius", Unmarshal checks that the
+//      matching element carries the attribute name with exactly that
+//      value and returns an error if it is missing or differs.
+//
+//   * If the struct has a field of type []Attr with tag ",rawattr",
+//      Unmarshal records in it every attribute of the element, in the
+//      order they appeared, with name spaces resolved as for the other
+//      fields. The attributes are recorded in addition to, and from the
+//      same parse as, any ",attr" fields.
+//
 //   * If the XML element contains character data, that data is
 //      accumulated in the first struct field that has tag ",chardata".
 //      The struct field may have type []byte or string.
@@ -488,12 +985,36 @@ func NewEncoder(w io.Writer) *Encoder
 //      field may have type []byte or string. If there is no such
 //      field, the comments are discarded.
 //
+//   * If the struct has a bool field with tag "name,presence",
+//      Unmarshal sets it to true if the element contains a sub-element
+//      with that name, whatever its content, and to false otherwise.
+//
+//   * If the struct has an int field with tag ",order", Unmarshal
+//      records in it the zero-based position of the element among the
+//      child elements of its parent, so that the original order can be
+//      recovered after elements of different names have been decoded
+//      into separate slices.
+//
+//   * If the struct has a field with tag ",select=selector", Unmarshal
+//      records in it the first match of the selector within the element.
+//      The selector is a minimal XPath-like expression made of steps
+//      separated by "/": "//name" matches a descendant element at any
+//      depth, "name" matches a child element, and a final "@name" selects
+//      an attribute of the matched element instead of its character data.
+//      For example, ",select=//item/price/@currency". The field receives
+//      the value as it would for a plain element or attribute.
+//
 //   * If the XML element contains a sub-element whose name matches
 //      the prefix of a tag formatted as "a" or "a>b>c", unmarshal
 //      will descend into the XML structure looking for elements with the
 //      given names, and will map the innermost elements to that struct
 //      field. A tag starting with ">" is equivalent to one starting
 //      with the field name followed by ">".
+//      A slice field tagged "a>b" receives every b element found inside
+//      the a element, in order; an empty a element leaves it empty.
+//      A slice-of-slices field tagged "a>b>c" receives one inner slice
+//      per b element, holding the c elements of that b, so the grouping
+//      of repeated intermediate elements is preserved.
 //
 //   * If the XML element contains a sub-element whose name matches
 //      a struct field's XMLName tag and the struct field has no
@@ -508,9 +1029,20 @@ func NewEncoder(w io.Writer) *Encoder
 //      of the above rules and the struct has a field with tag ",any",
 //      unmarshal maps the sub-element to that struct field.
 //
+//   * If that ",any" field has an interface type, or is a slice of an
+//      interface type, and the sub-element's name was registered with
+//      Decoder.Register, unmarshal allocates a new value of the registered
+//      type, maps the sub-element to it and stores it in the field. A
+//      sub-element whose name is not registered is skipped.
+//
 //   * An anonymous struct field is handled as if the fields of its
 //      value were part of the outer struct.
 //
+//   * An anonymous field of a named scalar type (string, []byte, bool
+//      or numeric) receives the character data of the element, as if
+//      tagged ",chardata". An anonymous field of a named slice type
+//      receives each sub-element named after the field's type.
+//
 //   * A struct field with tag "-" is never unmarshalled into.
 //
 // Unmarshal maps an XML element to a string or []byte by saving the
@@ -531,9 +1063,32 @@ func NewEncoder(w io.Writer) *Encoder
 // interpreting the string value in decimal. There is no check for
 // overflow.
 //
+// An empty element or attribute value, after trimming surrounding white
+// space, leaves a bool, integer or floating-point field at its zero value
+// without error, whether the value is given as an element or as an
+// attribute. Setting Decoder.StrictEmpty makes such an empty value an
+// error instead.
+//
+// Unmarshal maps an XML element or attribute value to a time.Time field
+// tagged ",date", ",time" or ",datetime" by parsing it with the XSD
+// xs:date, xs:time or xs:dateTime layout respectively. The time zone
+// suffix is optional; a value without one is given the location
+// NoTimeZone, which has zero offset but is marshaled back without a suffix,
+// so that a date such as 2024-01-02 round-trips unchanged. An
+// xs:date value sets the clock to midnight and an xs:time value sets the
+// date to January 1, year 0. A value that does not match the layout
+// results in an error naming the field.
+//
 // Unmarshal maps an XML element to a Name by recording the element
 // name.
 //
+// Unmarshal maps an XML element or attribute value to a value implementing
+// encoding.TextUnmarshaler, such as a *big.Int or *big.Rat, by calling its
+// UnmarshalText method with the character data or attribute value. A nil
+// pointer of such a type is first set to a freshly allocated value.
+// Elements and attributes are handled alike, so a type that round-trips
+// through MarshalText and UnmarshalText may be used as either.
+//
 // Unmarshal maps an XML element to a pointer by setting the pointer
 // to a freshly allocated value and then mapping the element to that value.
 
@@ -568,6 +1123,24 @@ func NewEncoder(w io.Writer) *Encoder
 //      the explicit name in a struct field tag of the form "name,attr",
 //      Unmarshal records the attribute value in that field.
 //
+//   * If the attribute's field is a slice with the "list" option, such
+//      as "class,attr,list", Unmarshal splits the attribute value on
+//      white space, or on the separator given as "list=sep" ("list=comma"
+//      for a comma, "list=" for white space), and records each part,
+//      converted to the element type of the slice, as an entry. An empty
+//      attribute value yields an empty, non-nil slice.
+//
+//   * If a field's tag includes an option of the form "attr:name=value",
+//      such as "temperature,attr:unit=celsius", Unmarshal checks that the
+//      matching element carries the attribute name with exactly that
+//      value and returns an error if it is missing or differs.
+//
+//   * If the struct has a field of type []Attr with tag ",rawattr",
+//      Unmarshal records in it every attribute of the element, in the
+//      order they appeared, with name spaces resolved as for the other
+//      fields. The attributes are recorded in addition to, and from the
+//      same parse as, any ",attr" fields.
+//
 //   * If the XML element contains character data, that data is
 //      accumulated in the first struct field that has tag ",chardata".
 //      The struct field may have type []byte or string.
@@ -578,12 +1151,36 @@ func NewEncoder(w io.Writer) *Encoder
 //      field may have type []byte or string. If there is no such
 //      field, the comments are discarded.
 //
+//   * If the struct has a bool field with tag "name,presence",
+//      Unmarshal sets it to true if the element contains a sub-element
+//      with that name, whatever its content, and to false otherwise.
+//
+//   * If the struct has an int field with tag ",order", Unmarshal
+//      records in it the zero-based position of the element among the
+//      child elements of its parent, so that the original order can be
+//      recovered after elements of different names have been decoded
+//      into separate slices.
+//
+//   * If the struct has a field with tag ",select=selector", Unmarshal
+//      records in it the first match of the selector within the element.
+//      The selector is a minimal XPath-like expression made of steps
+//      separated by "/": "//name" matches a descendant element at any
+//      depth, "name" matches a child element, and a final "@name" selects
+//      an attribute of the matched element instead of its character data.
+//      For example, ",select=//item/price/@currency". The field receives
+//      the value as it would for a plain element or attribute.
+//
 //   * If the XML element contains a sub-element whose name matches
 //      the prefix of a tag formatted as "a" or "a>b>c", unmarshal
 //      will descend into the XML structure looking for elements with the
 //      given names, and will map the innermost elements to that struct
 //      field. A tag starting with ">" is equivalent to one starting
 //      with the field name followed by ">".
+//      A slice field tagged "a>b" receives every b element found inside
+//      the a element, in order; an empty a element leaves it empty.
+//      A slice-of-slices field tagged "a>b>c" receives one inner slice
+//      per b element, holding the c elements of that b, so the grouping
+//      of repeated intermediate elements is preserved.
 //
 //   * If the XML element contains a sub-element whose name matches
 //      a struct field's XMLName tag and the struct field has no
@@ -598,9 +1195,20 @@ func NewEncoder(w io.Writer) *Encoder
 //      of the above rules and the struct has a field with tag ",any",
 //      unmarshal maps the sub-element to that struct field.
 //
+//   * If that ",any" field has an interface type, or is a slice of an
+//      interface type, and the sub-element's name was registered with
+//      Decoder.Register, unmarshal allocates a new value of the registered
+//      type, maps the sub-element to it and stores it in the field. A
+//      sub-element whose name is not registered is skipped.
+//
 //   * An anonymous struct field is handled as if the fields of its
 //      value were part of the outer struct.
 //
+//   * An anonymous field of a named scalar type (string, []byte, bool
+//      or numeric) receives the character data of the element, as if
+//      tagged ",chardata". An anonymous field of a named slice type
+//      receives each sub-element named after the field's type.
+//
 //   * A struct field with tag "-" is never unmarshalled into.
 //
 // Unmarshal maps an XML element to a string or []byte by saving the
@@ -621,13 +1229,47 @@ func NewEncoder(w io.Writer) *Encoder
 // interpreting the string value in decimal. There is no check for
 // overflow.
 //
+// An empty element or attribute value, after trimming surrounding white
+// space, leaves a bool, integer or floating-point field at its zero value
+// without error, whether the value is given as an element or as an
+// attribute. Setting Decoder.StrictEmpty makes such an empty value an
+// error instead.
+//
+// Unmarshal maps an XML element or attribute value to a time.Time field
+// tagged ",date", ",time" or ",datetime" by parsing it with the XSD
+// xs:date, xs:time or xs:dateTime layout respectively. The time zone
+// suffix is optional; a value without one is given the location
+// NoTimeZone, which has zero offset but is marshaled back without a suffix,
+// so that a date such as 2024-01-02 round-trips unchanged. An
+// xs:date value sets the clock to midnight and an xs:time value sets the
+// date to January 1, year 0. A value that does not match the layout
+// results in an error naming the field.
+//
 // Unmarshal maps an XML element to an xml.Name by recording the
 // element name.
 //
+// Unmarshal maps an XML element or attribute value to a value implementing
+// encoding.TextUnmarshaler, such as a *big.Int or *big.Rat, by calling its
+// UnmarshalText method with the character data or attribute value. A nil
+// pointer of such a type is first set to a freshly allocated value.
+// Elements and attributes are handled alike, so a type that round-trips
+// through MarshalText and UnmarshalText may be used as either.
+//
 // Unmarshal maps an XML element to a pointer by setting the pointer
 // to a freshly allocated value and then mapping the element to that value.
 func Unmarshal(data []byte, v interface{}) error
 
+// Declaration returns the contents of the <?xml ?> declaration at the start
+// of the input, if one has been read. The present result reports whether a
+// declaration was seen; the other results are valid only when it is true.
+// Encoding is empty if the declaration names no encoding, and standalone is
+// nil if it has no standalone pseudo-attribute.
+
+// Declaration返回输入开头的<?xml ?>声明的内容（如果已经读取到的话）。present返回
+// 值报告是否遇到了该声明，其他返回值只在present为真时有效。如果声明中没有指定编码
+// ，encoding为空；如果没有standalone伪属性，standalone为nil。
+func (d *Decoder) Declaration() (version, encoding string, standalone *bool, present bool)
+
 // Decode works like Unmarshal, except it reads the decoder
 // stream to find the start element.
 
@@ -646,19 +1288,78 @@ func (d *Decoder) Decode(v interface{}) error
 // but also wants to defer to Unmarshal for some elements.
 func (d *Decoder) DecodeElement(v interface{}, start *StartElement) error
 
+// DecodeFragment reads a sequence of top-level elements that need not share
+// a single root, calling fn with the start element of each. fn may consume
+// the element, typically with DecodeElement; whatever it leaves unread is
+// skipped. Character data, comments and other tokens between the elements
+// are ignored. DecodeFragment returns nil at EOF, or the first error
+// returned by fn or encountered while reading.
+
+// DecodeFragment读取一系列不必共享同一个根元素的顶层元素，并对每个元素的起始元素调
+// 用fn。fn可以“消费”该元素，通常使用DecodeElement；它未读取的部分会被跳过。元素之
+// 间的字符数据、注释和其他token会被忽略。DecodeFragment在遇到EOF时返回nil，否则返回
+// fn返回的或读取时遇到的第一个错误。
+func (d *Decoder) DecodeFragment(fn func(*StartElement) error) error
+
+// Depth returns the number of start elements that are open at the current
+// decoder position, or 0 at the top level. A self-closing element such as
+// <br/> is reported as a StartElement followed by an EndElement, so Depth
+// is one greater between the two tokens and unchanged after them.
+
+// Depth返回在解码器当前位置处尚未关闭的起始元素的数量，位于顶层时返回0。诸如<br/>
+// 这样的自闭合元素会被报告为一个StartElement后跟一个EndElement，因此在两个token之
+// 间Depth会加一，而在它们之后保持不变。
+func (d *Decoder) Depth() int
+
 // InputOffset returns the input stream byte offset of the current decoder
 // position. The offset gives the location of the end of the most recently
 // returned token and the beginning of the next token.
 func (d *Decoder) InputOffset() int64
 
+// More reports whether another start element follows before the end of the
+// current enclosing element, or before the end of the input at the top
+// level. Character data, comments and other tokens in between are skipped
+// over but not consumed, so the next call to Token or Decode still returns
+// them. More returns false at EOF and on a read error, which the next call
+// to Token reports.
+
+// More报告在当前外层元素结束之前（在顶层时则为输入结束之前）是否还有另一个起始元
+// 素。其间的字符数据、注释和其他token会被略过但不会被消费，因此下一次调用Token或
+// Decode仍会返回它们。More在遇到EOF或读取错误时返回false，该错误会由下一次Token
+// 调用报告。
+func (d *Decoder) More() bool
+
 // RawToken is like Token but does not verify that
 // start and end elements match and does not translate
 // name space prefixes to their corresponding URLs.
+//
+// RawToken returns every run of character data exactly as it appeared in
+// the input, with entities resolved; in particular, whitespace-only
+// character data between elements is never dropped or normalized.
+// Re-encoding the tokens of a document in order therefore reproduces its
+// whitespace exactly.
 
 // RawToken方法Token方法，但不会验证起始和结束标签，也不将名字空间前缀翻译为它们
 // 相应的URL。
+//
+// RawToken会按输入中的原样返回每一段字符数据（实体已被解析）；特别是元素之间仅包含
+// 空白的字符数据永远不会被丢弃或规范化。因此按顺序重新编码文档的token可以精确地重
+// 现其空白。
 func (d *Decoder) RawToken() (Token, error)
 
+// Register records that an element named name, when mapped to a ",any"
+// field of interface type or of a slice of interface type, is decoded into a
+// new value of the same type as proto. If proto is a pointer, the new value
+// is a pointer to a freshly allocated value of the pointed-to type. A name
+// with an empty Space matches elements in any name space. Register panics
+// if name is already registered.
+
+// Register记录名为name的元素在映射到接口类型或接口类型切片的",any"字段时，应被解码
+// 为一个与proto类型相同的新值。如果proto是指针，新值是指向其所指类型的新分配值的
+// 指针。Space为空的name可以匹配任意名字空间中的元素。如果name已经注册过，Register
+// 会panic。
+func (d *Decoder) Register(name Name, proto interface{})
+
 // Skip reads tokens until it has consumed the end element
 // matching the most recent start element already consumed.
 // It recurs if it encounters a start element, so it can be used to
@@ -671,6 +1372,18 @@ func (d *Decoder) RawToken() (Token, error)
 // 始标签的结束标签，会返回nil；否则返回一个描述该问题的错误。
 func (d *Decoder) Skip() error
 
+// Text reads tokens until it has consumed the end element matching the most
+// recent start element already consumed, and returns the concatenation of
+// all character data within it, including that of nested elements, with
+// entities resolved. Comments and processing instructions are skipped. The
+// text is returned as is; callers wanting it trimmed can use
+// strings.TrimSpace.
+
+// Text从底层读取token，直到读取到最近一次读取到的起始标签对应的结束标签，并返回其中
+// 所有字符数据（包括嵌套元素中的字符数据，实体已被解析）的拼接结果。注释和处理指令
+// 会被跳过。返回的文本保持原样；如需去除首尾空白，可以使用strings.TrimSpace。
+func (d *Decoder) Text() (string, error)
+
 // Token returns the next XML token in the input stream.
 // At the end of the input stream, Token returns nil, io.EOF.
 //
@@ -687,6 +1400,9 @@ func (d *Decoder) Skip() error
 // if Token encounters an unexpected end element
 // or EOF before all expected end elements,
 // it will return an error.
+// For an end element whose name, after name space translation, does not
+// match the open start element, the *SyntaxError gives both qualified names,
+// with their name space URLs, and the line of the start element.
 //
 // Token implements XML name spaces as described by
 // http://www.w3.org/TR/REC-xml-names/.  Each of the
@@ -704,12 +1420,31 @@ func (d *Decoder) Skip() error
 //
 // Token方法会保证它返回的StartElement和EndElement两种token正确的嵌套和匹配：如
 // 果本方法遇到了不正确的结束标签，会返回一个错误。
+// 如果结束标签经过名字空间翻译后的名称与当前打开的起始标签不匹配，返回的
+// *SyntaxError会给出两者的限定名（包括其名字空间URL）以及起始标签所在的行。
 //
 // Token方法实现了XML名字空间，细节参见http://www.w3.org/TR/REC-xml-names/。每一
 // 个包含在Token里的Name结构体，都会将Space字段设为URL标识（如果可知的话）。如果
 // Token遇到未知的名字空间前缀，它会使用该前缀作为名字空间，而不是报错。
 func (d *Decoder) Token() (Token, error)
 
+// Validate makes the decoder check the elements it reads against s. Once a
+// violation is found, such as a missing required child, a child occurring
+// too often or an unexpected child, Token returns an error describing it and
+// the path of the offending element; Decode and DecodeElement report the
+// same error. A nil s disables validation.
+
+// Validate使解码器根据s检查其读取到的元素。一旦发现违规情况，例如缺少必需的子元素
+// 、子元素出现次数过多或出现了意外的子元素，Token方法会返回一个描述该问题及出错元
+// 素路径的错误；Decode和DecodeElement也会报告相同的错误。s为nil时会关闭验证。
+func (d *Decoder) Validate(s *Schema)
+
+// WriteTo writes the document to w. A document returned by Parse and not
+// modified since is written exactly as it was read.
+
+// WriteTo将文档写入w。由Parse返回且未被修改过的文档会按读取时的原样写出。
+func (doc *Document) WriteTo(w io.Writer) (n int64, err error)
+
 // Encode writes the XML encoding of v to the stream.
 //
 // See the documentation for Marshal for details about the conversion
@@ -727,6 +1462,9 @@ func (enc *Encoder) Encode(v interface{}) error
 
 // EncodeElement writes the XML encoding of v to the stream,
 // using start as the outermost tag in the encoding.
+// If start is the zero StartElement, the content of v, such as the
+// fields of a struct, is written at the current position without an
+// enclosing element.
 //
 // See the documentation for Marshal for details about the conversion
 // of Go values to XML.
@@ -735,6 +1473,8 @@ func (enc *Encoder) Encode(v interface{}) error
 
 // EncodeElement writes the XML encoding of v to the stream, using start as the
 // outermost tag in the encoding.
+// If start is the zero StartElement, the content of v, such as the fields of a
+// struct, is written at the current position without an enclosing element.
 //
 // See the documentation for Marshal for details about the conversion of Go
 // values to XML.
@@ -742,6 +1482,17 @@ func (enc *Encoder) Encode(v interface{}) error
 // EncodeElement calls Flush before returning.
 func (enc *Encoder) EncodeElement(v interface{}, start StartElement) error
 
+// EncodeSlice writes the elements of v, which must be a slice or array, to
+// the stream: a wrapper start element, then each entry encoded as by
+// EncodeElement with an element named name, then the wrapper end element.
+// Output is flushed after each entry, so the memory used does not grow with
+// the length of v.
+
+// EncodeSlice将v（必须是切片或数组）的元素写入输出流：先写入wrapper起始元素，然后
+// 像EncodeElement一样将每个成员编码为名为name的元素，最后写入wrapper结束元素。每个
+// 成员写入后都会刷新输出，因此所用内存不会随v的长度增长。
+func (enc *Encoder) EncodeSlice(name Name, wrapper Name, v interface{}) error
+
 // EncodeToken writes the given XML token to the stream. It returns an error if
 // StartElement and EndElement tokens are not properly matched.
 //
@@ -754,6 +1505,11 @@ func (enc *Encoder) EncodeElement(v interface{}, start StartElement) error
 //
 // EncodeToken allows writing a ProcInst with Target set to "xml" only as the
 // first token in the stream.
+//
+// A CDATA token is written verbatim inside <![CDATA[ ... ]]>. Any "]]>" it
+// contains is split across consecutive CDATA sections, as for fields tagged
+// ",cdata". EncodeToken returns an error if a CDATA token is written outside
+// any element, where character data is not allowed.
 func (enc *Encoder) EncodeToken(t Token) error
 
 // Flush flushes any buffered XML to the underlying writer.
@@ -763,21 +1519,74 @@ func (enc *Encoder) EncodeToken(t Token) error
 // documentation for details about when it is necessary.
 func (enc *Encoder) Flush() error
 
+// InScope reports the name space URL bound to prefix at the current position
+// of the output. The empty prefix reports the default name space. It is
+// intended for use by MarshalXML methods, which can consult it to declare a
+// name space only when it is not already in scope.
+
+// InScope报告在输出的当前位置上绑定到prefix的名字空间URL。空前缀报告的是默认名字空
+// 间。本方法供MarshalXML方法使用，它们可以据此只在名字空间尚未处于作用域内时才声明
+// 它。
+func (enc *Encoder) InScope(prefix string) (url string, ok bool)
+
 // Indent sets the encoder to generate XML in which each element
 // begins on a new indented line that starts with prefix and is followed by
 // one or more copies of indent according to the nesting depth.
-
-// Indent sets the encoder to generate XML in which each element begins on a new
-// indented line that starts with prefix and is followed by one or more copies
-// of indent according to the nesting depth.
+// The nesting depth is shared by EncodeToken, Encode and EncodeElement,
+// so calls to them may be interleaved freely: an element encoded with
+// EncodeElement inside a StartElement written by EncodeToken is indented
+// one level deeper than that start element.
+// As with MarshalIndent, mixed content is never indented.
+
+// Indent设置编码器生成的XML中每个元素都另起一行并缩进，该行以prefix起始，后跟一或
+// 多个indent的拷贝（根据嵌套层数）。EncodeToken、Encode和EncodeElement共享同一个
+// 嵌套层数，因此可以任意交替调用它们：在EncodeToken写入的StartElement内部用
+// EncodeElement编码的元素会比该起始元素多缩进一层。
+// 与MarshalIndent一样，混合内容永远不会被缩进。
 func (enc *Encoder) Indent(prefix, indent string)
 
+// PreservePrefixes sets whether EncodeToken keeps the name space prefixes of
+// the tokens it writes. When enabled, a Name.Space that is a prefix bound by
+// an xmlns:prefix attribute of an enclosing or the current element, as
+// produced by RawToken, is written as that prefix rather than being
+// expanded into a declaration of a generated prefix, so that a document
+// decoded with RawToken is re-encoded with its original prefixes. A
+// Name.Space that is not a bound prefix is treated as a URL as usual.
+// Elements with an empty Name.Space are still placed in DefaultSpace,
+// which is declared with an unprefixed xmlns attribute and is unaffected
+// by this setting.
+
+// PreservePrefixes设置EncodeToken是否保留所写入token的名字空间前缀。启用后，若
+// Name.Space是由外层元素或当前元素的xmlns:prefix属性绑定的前缀（例如RawToken返回
+// 的那样），则会原样写为该前缀，而不是展开为一个生成前缀的声明，从而使通过RawToken
+// 解码的文档以其原始前缀重新编码。不是已绑定前缀的Name.Space仍照常被视为URL。
+// Name.Space为空的元素仍被置于DefaultSpace中，后者使用无前缀的xmlns属性声明，不受本
+// 设置的影响。
+func (enc *Encoder) PreservePrefixes(preserve bool)
+
+// RegisterType records that values with the same dynamic type as prototype
+// are described by the schema type name. When the encoder marshals an
+// interface value holding a registered type, it adds an xsi:type attribute
+// naming that type to the element, declaring the XML Schema instance name
+// space and the name space of name as needed. RegisterType panics if the
+// type of prototype is already registered.
+
+// RegisterType记录与prototype动态类型相同的值由模式类型name描述。当编码器序列化
+// 持有已注册类型的接口值时，会在元素上添加一个指明该类型的xsi:type属性，并按需声
+// 明XML Schema实例名字空间和name所在的名字空间。如果prototype的类型已经注册过，
+// RegisterType会panic。
+func (enc *Encoder) RegisterType(name Name, prototype interface{})
+
+func (e *LimitError) Error() string
+
 func (e *SyntaxError) Error() string
 
 func (e *TagPathError) Error() string
 
 func (e *UnsupportedTypeError) Error() string
 
+func (c CDATA) Copy() CDATA
+
 func (c CharData) Copy() CharData
 
 func (c Comment) Copy() Comment
@@ -786,6 +1595,11 @@ func (d Directive) Copy() Directive
 
 func (p ProcInst) Copy() ProcInst
 
+// Copy returns a copy of e with its own Attr slice, so that changes to the
+// attributes of one do not affect the other.
+
+// Copy返回e的一个拷贝，该拷贝拥有自己的Attr切片，因此对其中一个的属性所做的修改不会
+// 影响另一个。
 func (e StartElement) Copy() StartElement
 
 // End returns the corresponding XML end element.
@@ -24,15 +24,72 @@ import (
 	"image/color/palette"
 	"image/draw"
 	"io"
+	"time"
 )
 
-// Disposal Methods.
+// Disposal Methods. The constants are untyped so that they can be used both
+// in GIF.Disposal and in GIF.DisposalMethods.
 const (
 	DisposalNone       = 0x01
 	DisposalBackground = 0x02
 	DisposalPrevious   = 0x03
 )
 
+// An AnimBuilder assembles a GIF one frame at a time, taking delays as
+// time.Durations rather than hundredths of a second.
+// The zero value for an AnimBuilder is ready to use.
+
+// AnimBuilder 逐帧组装一个 GIF，其延迟时间以 time.Duration 而不是百分之一秒为单位。
+// AnimBuilder 的零值即可直接使用。
+type AnimBuilder struct {
+	LoopCount int // The loop count of the built GIF.
+}
+
+// DecodeOptions are the decoding parameters.
+
+// DecodeOptions 是解码参数。
+type DecodeOptions struct {
+	// ExpandCanvas, if true, makes DecodeAllOptions accept frames that
+	// extend beyond the logical screen, as many viewers do, by growing
+	// Config.Width and Config.Height to contain them. When false, such a
+	// frame is an error.
+	ExpandCanvas bool
+
+	// RawPalette, if true, makes DecodeAllOptions keep the color stored
+	// at each frame's transparent index instead of replacing it with a
+	// transparent color, for inspecting files exactly as written.
+	RawPalette bool
+}
+
+// A DisposalMethod is the disposal method of a frame: 0 (none specified) or
+// one of DisposalNone, DisposalBackground and DisposalPrevious.
+
+// DisposalMethod 是帧的处置方法：0（未指定）或 DisposalNone、DisposalBackground、
+// DisposalPrevious 之一。
+type DisposalMethod byte
+
+// FrameInfo describes a frame passed to the ExtractFrames callback.
+
+// FrameInfo 描述了传递给 ExtractFrames 回调函数的帧。
+type FrameInfo struct {
+	Delay    int             // The delay time, in 100ths of a second.
+	Disposal byte            // The disposal method.
+	Bounds   image.Rectangle // The bounds of the frame within the canvas.
+}
+
+// A FrameReader reads the frames of a GIF one at a time, as their bytes
+// arrive. It reads from the underlying reader only as much as it needs to
+// complete the current frame, so with a reader that delivers data in small
+// pieces and blocks in between, such as a network connection, each frame is
+// returned as soon as its last byte has been read, without waiting for the
+// rest of the stream or for EOF.
+
+// FrameReader 在 GIF 的字节到达时逐帧读取其帧。它只从底层读取器读取完成当前帧所需的
+// 数据，因此对于分小块传送数据并在其间阻塞的读取器（例如网络连接），每一帧都会在其最
+// 后一个字节被读取后立即返回，而无需等待流的其余部分或 EOF。
+type FrameReader struct {
+}
+
 // GIF represents the possibly multiple images stored in a GIF file.
 
 // GIF代表一个GIF文件上的多个图像。
@@ -46,6 +103,19 @@ type GIF struct {
 	// that each frame's disposal method is 0 (no disposal specified).
 	Disposal []byte
 
+	// DisposalMethods is a typed alternative to Disposal. When it is
+	// non-nil, EncodeAll uses it instead of Disposal and returns an error
+	// for any value other than 0 and the Disposal constants. DecodeAll sets
+	// both fields.
+	DisposalMethods []DisposalMethod
+
+	// UserInput is the successive user input flags of the graphic control
+	// extensions, one per frame. A frame whose flag is set waits for user
+	// input before continuing; if its Delay is also non-zero, it continues
+	// after whichever comes first. A nil UserInput is valid to pass to
+	// EncodeAll and implies that no frame waits for user input.
+	UserInput []bool
+
 	// Config is the global color table (palette), width and height. A nil or
 	// empty-color.Palette Config.ColorModel means that each frame has its own
 	// color table and there is no global color table. Each frame's bounds must
@@ -60,6 +130,38 @@ type GIF struct {
 	// BackgroundIndex is the background index in the global color table, for
 	// use with the DisposalBackground disposal method.
 	BackgroundIndex byte
+
+	// GlobalSorted reports whether the global color table is sorted in
+	// order of decreasing importance, as recorded in the logical screen
+	// descriptor. EncodeAll writes it back unchanged.
+	GlobalSorted bool
+
+	// GlobalColorTableSize is the number of entries in the global color
+	// table as declared in the logical screen descriptor, which may exceed
+	// the length of the color.Palette in Config.ColorModel. When non-zero,
+	// EncodeAll pads the table to this size; it must be a power of two
+	// between 2 and 256.
+	GlobalColorTableSize int
+
+	// ICCProfile is the ICC color profile embedded in an application
+	// extension with identifier "ICCRGBG1" and authentication code "012",
+	// reassembled from its data sub-blocks, or nil if there is none.
+	// EncodeAll writes it back when it is non-nil.
+	ICCProfile []byte
+
+	// PlainText is the plain text extension blocks of the GIF, in order.
+	// A nil PlainText is valid to pass to EncodeAll.
+	PlainText []PlainTextBlock
+
+	// Version is the format version from the header, "GIF87a" or "GIF89a".
+	// DecodeAll sets it to the version read. EncodeAll writes "GIF89a" when
+	// it is empty, and returns an error for "GIF87a" if g uses any feature
+	// that needs an extension block: more than one frame, a non-zero
+	// Delay, LoopCount, Disposal or UserInput, a transparent color,
+	// ICCProfile or PlainText. It also returns an error for "GIF87a" if
+	// GlobalSorted is set, since that format reserves the sort flag and
+	// requires it to be zero.
+	Version string
 }
 
 // Options are the encoding parameters.
@@ -68,15 +170,149 @@ type Options struct {
 	// It ranges from 1 to 256.
 	NumColors int
 
+	// Quality, if between 1 and 100 and NumColors is zero, lets the
+	// encoder choose the number of colors and the dithering strength.
+	// The mean color error of a quantized image is the average, over all
+	// pixels, of the Euclidean distance in 8-bit RGB between the source
+	// and the palette color (or of Distance, if set), and the bound for a
+	// given Quality is (100-Quality)/2. With n = 2+254*Quality/100, the
+	// number of colors used is the smallest of n, n/2, n/4, ..., down to
+	// 2, whose mean color error is within the bound, or n if none is, so
+	// simple images use fewer colors. Dithering is applied as by
+	// ScaledFloydSteinberg(float64(Quality)/100) unless Drawer is set. An
+	// explicit NumColors takes precedence.
+	Quality int
+
 	// Quantizer is used to produce a palette with size NumColors.
 	// palette.Plan9 is used in place of a nil Quantizer.
 	Quantizer draw.Quantizer
 
+	// Palette, if non-empty, is the exact palette every image is mapped
+	// to with Drawer; no quantization takes place. It takes precedence over
+	// NumColors, Quality and Quantizer, which are then ignored. It must
+	// have at most 256 colors, or at most 255 if PreserveAlpha or
+	// TransparentColor is set, since the entry reserved for transparency is
+	// then appended to it. palette.WebSafe gives the 216-color web-safe
+	// palette.
+	Palette color.Palette
+
 	// Drawer is used to convert the source image to the desired palette.
 	// draw.FloydSteinberg is used in place of a nil Drawer.
 	Drawer draw.Drawer
+
+	// Distance, if non-nil, is the color distance used when the encoder
+	// maps pixels to palette entries, in place of the squared Euclidean
+	// distance in RGB space. It is used by every drawer this package
+	// provides: the default used when Drawer is nil, the dithering chosen
+	// for Quality, and any Drawer returned by ScaledFloydSteinberg. Other
+	// drawers do their own mapping, so Encode and EncodeAllOptions return
+	// an error if Distance is set together with such a Drawer. A
+	// perceptual metric, such as weighted RGB, often gives better results
+	// for photographs.
+	Distance func(c1, c2 color.Color) float64
+
+	// PreserveAlpha, if true, reserves one palette entry for transparency
+	// when the source image has an alpha channel. Pixels whose 8-bit alpha
+	// is at or below AlphaCutoff are mapped to that entry and the frame's
+	// transparent index is set to it; all other pixels are treated as
+	// opaque.
+	PreserveAlpha bool
+
+	// AlphaCutoff is the threshold used by PreserveAlpha. The zero value
+	// makes only fully transparent pixels transparent.
+	AlphaCutoff uint8
+
+	// TransparentColor, if non-nil, is a color to treat as transparent,
+	// such as a chroma-key background. One palette entry is reserved for
+	// transparency, every pixel matching TransparentColor is mapped to it
+	// during quantization, and the frame's transparent index is set to it.
+	// If PreserveAlpha is also set, both share that single entry: a pixel
+	// becomes transparent if it matches TransparentColor or its alpha is at
+	// or below AlphaCutoff.
+	//
+	// The reserved entry is always the last one of the palette. When the
+	// encoder quantizes, the Quantizer is asked for one color fewer than
+	// NumColors. When Palette is set, a transparent entry is appended to
+	// it at index len(Palette), and Encode returns an error if Palette
+	// already has 256 colors.
+	TransparentColor color.Color
+
+	// TransparentTolerance is the largest difference, per 8-bit RGB
+	// channel, at which a pixel still matches TransparentColor. The zero
+	// value requires an exact match.
+	TransparentTolerance uint8
+
+	// FullCanvasFrames, if true, makes EncodeAllOptions expand every frame
+	// to the full Config.Width by Config.Height canvas, so that no frame has
+	// a non-zero offset or smaller bounds. The added area shows the previous
+	// frame through the transparent index, or the background color where
+	// there is none, so the animation looks the same. This works around
+	// viewers that mishandle partial frames, at the cost of larger files.
+	FullCanvasFrames bool
+
+	// MinDelay is the minimum frame delay, in 100ths of a second, written
+	// by EncodeAllOptions; any smaller Delay is raised to it. Most browsers
+	// replace delays below 2 with a much slower default of 10, so a
+	// MinDelay of 2 makes fast animations play consistently. Zero means no
+	// minimum.
+	MinDelay int
+
+	// TrimTransparentRows, if true, makes EncodeAllOptions shrink the
+	// bounds of each frame after the first to exclude bottom rows made up
+	// entirely of the frame's transparent index. Viewers leave pixels
+	// outside a frame's bounds unchanged, exactly as they do transparent
+	// ones, so the animation looks the same but is smaller. It has no
+	// effect on frames without a transparent index.
+	TrimTransparentRows bool
+
+	// FlushSubBlocks, if true, makes Encode and EncodeAllOptions write each
+	// 255-byte image data sub-block to the underlying writer as soon as it
+	// fills instead of after the whole frame has been compressed, lowering
+	// latency when the output, such as an animation, is streamed. The LZW
+	// dictionary is unaffected, but flushing partial output defeats some
+	// buffering and can slightly increase the encoded size.
+	FlushSubBlocks bool
+}
+
+// A PlainTextBlock is a plain text extension, which renders text on a grid of
+// character cells using colors from the global color table.
+// EncodeAll returns an error if the grid does not fit within the logical
+// screen, if a cell is zero-sized or if a color index is outside the global
+// color table; it splits Text into sub-blocks as required.
+
+// PlainTextBlock 是一个纯文本扩展，它使用全局颜色表中的颜色在字符单元格组成的网格上渲
+// 染文本。如果网格超出了逻辑屏幕、单元格尺寸为零，或颜色索引超出了全局颜色表，
+// EncodeAll 会返回错误；它会按需将 Text 拆分为子块。
+type PlainTextBlock struct {
+	// Frame is the index of the frame the block is written before. A
+	// value equal to len(Image) places the block after the last frame.
+	Frame int
+
+	Left, Top     uint16 // The position of the grid on the logical screen.
+	Width, Height uint16 // The size of the grid, in pixels.
+	CellWidth     byte   // The width of a character cell, in pixels.
+	CellHeight    byte   // The height of a character cell, in pixels.
+	Foreground    byte   // The global color table index of the text.
+	Background    byte   // The global color table index of the background.
+	Text          []byte // The text to render.
 }
 
+// Concat decodes the GIFs read from srcs and writes their frames to w, in
+// order, as a single animation, preserving each frame's delay and disposal.
+// The canvas is as large as the largest source; smaller sources are placed
+// at its top-left corner and the remaining area is transparent. Each source
+// is fully disposed of to the background before the next one starts, so
+// frames never show through across a seam. Frames keep their own palettes
+// as local color tables; o is applied as by EncodeAllOptions, and the loop
+// count of the first source is used.
+
+// Concat 解码从 srcs 读取的各个 GIF，并将它们的帧依次作为单个动画写入 w，同时保留每
+// 一帧的延迟和处置方法。画布与最大的源一样大；较小的源放置在画布左上角，其余区域是透
+// 明的。每个源在下一个源开始之前都会被完全处置为背景，因此帧在衔接处永远不会相互透出
+// 。各帧将各自的调色板保留为局部颜色表；o 的应用方式与 EncodeAllOptions 相同，并使用
+// 第一个源的循环次数。
+func Concat(w io.Writer, srcs []io.Reader, o *Options) error
+
 // Decode reads a GIF image from r and returns the first embedded
 // image as an image.Image.
 
@@ -85,10 +321,62 @@ func Decode(r io.Reader) (image.Image, error)
 
 // DecodeAll reads a GIF image from r and returns the sequential frames
 // and timing information.
+//
+// Each frame holds its pixel indices exactly as stored, including pixels
+// that use the transparent index; transparency is never applied to the
+// pixels, and compositing frames is left to the caller or to ExtractFrames.
+// The palette entry at the transparent index is replaced by a transparent
+// color; use DecodeAllOptions with RawPalette to keep the stored color.
 
 // DecodeAll 从r上读取一个GIF图片，并且返回顺序的帧和时间信息。
+//
+// 每一帧按存储时的原样保存其像素索引，包括使用透明索引的像素；透明度永远不会应用到像
+// 素上，帧的合成由调用者或 ExtractFrames 负责。透明索引处的调色板条目会被替换为透明
+// 颜色；使用带 RawPalette 的 DecodeAllOptions 可以保留存储的颜色。
 func DecodeAll(r io.Reader) (*GIF, error)
 
+// DecodeAllOptions is like DecodeAll but applies the settings in o. A nil o
+// is equivalent to calling DecodeAll.
+
+// DecodeAllOptions 类似于 DecodeAll，但会应用 o 中的设置。o 为 nil 时等价于调用
+// DecodeAll。
+func DecodeAllOptions(r io.Reader, o *DecodeOptions) (*GIF, error)
+
+// DecodeAllRGBA is like DecodeAll but converts each frame to an *image.RGBA
+// with the same bounds by looking up its pixels in the frame's palette.
+// Pixels using the frame's transparent index become fully transparent. The
+// frames are converted independently; disposal is not applied.
+
+// DecodeAllRGBA 类似于 DecodeAll，但会通过在帧的调色板中查找像素，将每一帧转换为具有
+// 相同边界的 *image.RGBA。使用该帧透明索引的像素会变为完全透明。各帧独立转换，不会应
+// 用处置方法。
+func DecodeAllRGBA(r io.Reader) (frames []*image.RGBA, delays []int, loop int, err error)
+
+// DecodeAllScaled is like DecodeAll but box-samples each frame down so that
+// the canvas fits within maxW by maxH pixels, preserving the aspect ratio.
+// Frames are never scaled up. Disposal is applied at full resolution before
+// scaling, and the scaled frames are re-quantized against a single shared
+// palette. Delay, LoopCount and Disposal are preserved.
+
+// DecodeAllScaled 类似于 DecodeAll，但会对每一帧进行盒式采样缩小，使画布在保持宽高比
+// 的前提下适应 maxW × maxH 像素的范围。帧不会被放大。处置方法会在缩放前以原始分辨率
+// 应用，缩放后的帧会根据同一个共享调色板重新量化。Delay、LoopCount 和 Disposal 都
+// 会被保留。
+func DecodeAllScaled(r io.Reader, maxW, maxH int) (*GIF, error)
+
+// DecodeComposited reads a GIF image from r and calls fn once per frame with
+// the composited canvas, after the frame and the disposal of the previous
+// frame have been applied, and the frame's delay. Only the current canvas
+// and the incoming frame are held in memory; the canvas and decode buffers
+// are reused between calls, so fn must not retain canvas after it returns.
+// If fn returns an error, DecodeComposited stops and returns that error.
+
+// DecodeComposited 从 r 读取一个 GIF 图像，并对每一帧调用一次 fn，传入应用该帧及上一
+// 帧的处置方法之后的合成画布，以及该帧的延迟。内存中只保留当前画布和正在读取的帧；
+// 画布和解码缓冲区会在调用之间复用，因此 fn 返回后不得继续持有 canvas。若 fn 返回错
+// 误，DecodeComposited 会停止并返回该错误。
+func DecodeComposited(r io.Reader, fn func(canvas *image.RGBA, delay time.Duration) error) error
+
 // DecodeConfig returns the global color model and dimensions of a GIF image
 // without decoding the entire image.
 
@@ -100,8 +388,180 @@ func Encode(w io.Writer, m image.Image, o *Options) error
 
 // EncodeAll writes the images in g to w in GIF format with the
 // given loop count and delay between frames.
+//
+// The output is deterministic: encoding the same GIF twice produces
+// byte-identical results, independent of map iteration order or other
+// run-to-run variation, so it is safe to hash for content-addressed storage.
 
-// EncodeAll writes the images in g to w in GIF format with the given loop count
-// and delay between frames.
+// EncodeAll 将 g 中的图像以 GIF 格式写入 w，并使用给定的循环次数和帧间延迟。
+//
+// 输出是确定性的：对同一个 GIF 编码两次会产生逐字节相同的结果，不受映射迭代顺序或
+// 其他运行间差异的影响，因此可以安全地对其计算哈希以用于内容寻址存储。
 func EncodeAll(w io.Writer, g *GIF) error
 
+// EncodeAllOptions is like EncodeAll but applies the animation-related
+// settings in o, such as FullCanvasFrames, and the output setting
+// FlushSubBlocks. The quantization settings in o are ignored because the
+// frames of g are already paletted. A nil o is equivalent to calling
+// EncodeAll.
+
+// EncodeAllOptions 类似于 EncodeAll，但会应用 o 中与动画相关的设置，例如
+// FullCanvasFrames，以及输出设置 FlushSubBlocks。由于 g 中的帧已经是调色板图像，
+// o 中的量化设置会被忽略。o 为 nil 时等价于调用 EncodeAll。
+func EncodeAllOptions(w io.Writer, g *GIF, o *Options) error
+
+// EncodeTimed writes frames to w as an animated GIF with the given loop
+// count. Each frame is shown until the timestamp of the next one, rounded to
+// the nearest hundredth of a second; the last frame is shown for as long as
+// the one before it. A single frame is written with a delay of 0, and an
+// empty frames is an error, as for EncodeAll. The frames are quantized
+// against a single palette shared by all of them, using o as Encode does.
+// It returns an error if the slices differ in length or the timestamps are
+// not increasing.
+
+// EncodeTimed 将 frames 作为具有给定循环次数的动画 GIF 写入 w。每一帧一直显示到下一帧
+// 的时间戳为止，并舍入到最接近的百分之一秒；最后一帧的显示时长与前一帧相同。只有一帧
+// 时以延迟 0 写出；frames 为空时与 EncodeAll 一样返回错误。所有帧都会像 Encode 一样
+// 使用 o，根据一个共享的调色板进行量化。如果两个切片长度不同，或时间戳不是递增的，
+// 则返回错误。
+func EncodeTimed(w io.Writer, frames []image.Image, timestamps []time.Duration, loop int, o *Options) error
+
+// ExtractFrames decodes the GIF read from r and calls fn for each frame, in
+// order, with the fully rendered canvas: the frame composited over the
+// previous frames according to their disposal methods. The image passed to
+// fn is only valid until fn returns. If fn returns an error, ExtractFrames
+// stops and returns that error.
+
+// ExtractFrames 解码从 r 读取的 GIF，并按顺序对每一帧调用 fn，传入完整渲染后的画布：
+// 即按照之前各帧的处置方法将该帧合成到之前的帧之上的结果。传递给 fn 的图像只在 fn 返
+// 回之前有效。如果 fn 返回错误，ExtractFrames 会停止并返回该错误。
+func ExtractFrames(r io.Reader, fn func(index int, img image.Image, info FrameInfo) error) error
+
+// NewFrameReader reads the header and logical screen descriptor of the GIF
+// from r and returns a FrameReader positioned before the first frame.
+
+// NewFrameReader 从 r 读取 GIF 的文件头和逻辑屏幕描述符，并返回一个位于第一帧之前的
+// FrameReader。
+func NewFrameReader(r io.Reader) (*FrameReader, error)
+
+// ReplaceFrame copies the GIF read from src to dst, replacing the image data
+// of the frame at the given index with newFrame. newFrame must have the same
+// bounds as the frame it replaces. Only that frame's image data, and its local
+// color table if the palette differs, are rewritten; every other byte of src
+// is copied verbatim. It returns an error if index is out of range.
+
+// ReplaceFrame 将从 src 读取的 GIF 复制到 dst，并用 newFrame 替换指定索引处帧的图像
+// 数据。newFrame 的边界必须与被替换的帧相同。只有该帧的图像数据，以及调色板不同时
+// 其局部颜色表会被重写；src 中的其他字节都会原样复制。若 index 越界则返回错误。
+func ReplaceFrame(dst io.Writer, src io.Reader, index int, newFrame *image.Paletted) error
+
+// ScaledFloydSteinberg returns a draw.Drawer, suitable for Options.Drawer,
+// that performs Floyd-Steinberg error diffusion but distributes only the
+// given fraction of each pixel's quantization error to its neighbors.
+// Strength is clamped to [0, 1]: 1 is equivalent to draw.FloydSteinberg and
+// 0 diffuses no error, which is plain nearest-color mapping. When it is
+// used as Options.Drawer, colors are matched with Options.Distance if that
+// is set.
+
+// ScaledFloydSteinberg 返回一个适合用作 Options.Drawer 的 draw.Drawer，它执行
+// Floyd-Steinberg 误差扩散，但只将每个像素量化误差的给定比例分配给相邻像素。strength
+// 会被限制在 [0, 1] 范围内：1 等价于 draw.FloydSteinberg，0 不扩散任何误差，即普通
+// 的最近颜色映射。将其用作 Options.Drawer 时，若设置了 Options.Distance，则使用它
+// 来匹配颜色。
+func ScaledFloydSteinberg(strength float64) draw.Drawer
+
+// AddFrame appends img to the animation, shown for delay and disposed of
+// with the given disposal method. The delay is rounded to the nearest
+// hundredth of a second, the resolution of the GIF format; Rounded reports
+// the frames for which that changed the delay. AddFrame returns an error if
+// delay is negative or longer than 65535 hundredths of a second.
+
+// AddFrame 将 img 追加到动画中，显示 delay 时长，并使用给定的处置方法。delay 会被舍
+// 入到最接近的百分之一秒，这是 GIF 格式的精度；Rounded 会报告哪些帧的延迟因此发生了
+// 变化。如果 delay 为负或超过 65535 个百分之一秒，AddFrame 会返回错误。
+func (b *AnimBuilder) AddFrame(img *image.Paletted, delay time.Duration, disposal byte) error
+
+// Build returns a GIF holding the frames added so far.
+
+// Build 返回一个包含目前已添加的所有帧的 GIF。
+func (b *AnimBuilder) Build() *GIF
+
+// Rounded returns the indices of the frames whose delay could not be
+// represented exactly and was rounded.
+
+// Rounded 返回那些延迟无法精确表示而被舍入的帧的索引。
+func (b *AnimBuilder) Rounded() []int
+
+// Config returns the global color model and dimensions of the GIF.
+
+// Config 返回该 GIF 的全局颜色模型和尺寸。
+func (fr *FrameReader) Config() image.Config
+
+// Next reads and returns the next frame. It returns io.EOF after the last
+// frame, once the trailer has been read.
+
+// Next 读取并返回下一帧。在读取到文件尾部标记后，最后一帧之后的调用会返回 io.EOF。
+func (fr *FrameReader) Next() (*image.Paletted, FrameInfo, error)
+
+// EncodedSize returns the exact number of bytes EncodeAll would write for g.
+// It runs the same encoder against a writer that only counts bytes, so no
+// output buffer is allocated.
+
+// EncodedSize 返回 EncodeAll 为 g 写入的确切字节数。它针对一个只计数字节的写入器运行
+// 相同的编码器，因此不会分配输出缓冲区。
+func (g *GIF) EncodedSize() (int, error)
+
+// FrameAt returns the index of the frame visible at playback time t,
+// measured from the start of the first frame. Frames whose Delay is zero are
+// shown for zeroDelay, so passing zero treats them as instantaneous while
+// passing 100*time.Millisecond mimics the clamp applied by most browsers.
+// If LoopCount is zero the animation repeats forever and t wraps around;
+// otherwise the animation plays LoopCount+1 times and FrameAt returns the
+// last frame for any later t. It returns 0 for a negative t and -1 if g has
+// no frames.
+
+// FrameAt 返回在播放时间 t（从第一帧开始计算）时可见帧的索引。Delay 为零的帧会显示
+// zeroDelay 的时长，因此传入零表示这些帧是瞬时的，而传入 100*time.Millisecond 则模
+// 仿大多数浏览器的限制。如果 LoopCount 为零，动画会无限重复，t 会循环回绕；否则动画
+// 播放 LoopCount+1 次，对于之后的 t，FrameAt 返回最后一帧。t 为负时返回 0，g 没有
+// 帧时返回 -1。
+func (g *GIF) FrameAt(t, zeroDelay time.Duration) int
+
+// Reverse reverses the order of the frames of g, together with their Delay,
+// Disposal, DisposalMethods and UserInput entries. Each PlainText block
+// stays with its frame: its Frame index f becomes len(Image)-1-f, except
+// that a block placed after the last frame, with f equal to len(Image),
+// stays there. The order of the PlainText slice is reversed to match.
+//
+// Reverse does not re-render frames. A frame that draws only what changed
+// since the previous frame, through transparency or bounds smaller than the
+// canvas, depends on the frames before it and will not look the same when
+// they come after it. Animations whose frames each cover the whole canvas
+// reverse correctly; others should first be rendered with ExtractFrames.
+
+// Reverse 反转 g 中帧的顺序，以及与之对应的 Delay、Disposal、DisposalMethods 和
+// UserInput 条目。每个 PlainText 块仍跟随其所在的帧：其 Frame 索引 f 变为
+// len(Image)-1-f，但位于最后一帧之后（即 f 等于 len(Image)）的块保持不变。PlainText
+// 切片的顺序也会相应反转。
+//
+// Reverse 不会重新渲染帧。通过透明度或小于画布的边界只绘制相对于前一帧变化部分的帧，
+// 依赖于它之前的帧，当这些帧移到它之后时，其显示效果将会不同。每一帧都覆盖整个画布的
+// 动画可以被正确反转；其他动画应先用 ExtractFrames 渲染。
+func (g *GIF) Reverse()
+
+// ScaleSpeed multiplies every frame delay by factor, so that a factor of
+// 0.5 plays the animation twice as fast. Delays are rounded to the nearest
+// hundredth of a second and clamped to the range [0, 65535]; a non-zero
+// delay is never rounded down to zero. It panics if factor is not positive.
+
+// ScaleSpeed 将每一帧的延迟乘以 factor，因此 factor 为 0.5 时动画播放速度加倍。延迟会
+// 被舍入到最接近的百分之一秒，并限制在 [0, 65535] 范围内；非零的延迟永远不会被舍入为
+// 零。如果 factor 不是正数，则会引发 panic。
+func (g *GIF) ScaleSpeed(factor float64)
+
+// String returns the name of the disposal method, such as
+// "DisposalBackground", or a numeric form for an unknown value.
+
+// String 返回该处置方法的名称，例如 "DisposalBackground"；对于未知的值，则返回其数值
+// 形式。
+func (d DisposalMethod) String() string
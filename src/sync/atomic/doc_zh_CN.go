@@ -64,7 +64,147 @@
 // 	"*addr = val".
 package atomic
 
-import "unsafe"
+import (
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// A Duration is a time.Duration that is loaded and stored atomically, such as
+// a timeout that may be reconfigured while requests read it.
+// The zero value for a Duration is zero.
+// A Duration must not be copied after first use.
+
+// Duration 是一个以原子方式载入和存储的 time.Duration，例如可能在请求读取它时被重新
+// 配置的超时时间。Duration 的零值为零。Duration 在首次使用后不能被复制。
+type Duration struct {
+}
+
+// An Event is a one-shot signal that can be set once and observed by any
+// number of goroutines without blocking.
+// Signal publishes the event with release semantics and IsSet observes it
+// with acquire semantics, so writes made before Signal are visible to a
+// goroutine that sees IsSet return true.
+// Events are a cheap flag for hot paths; use a channel when the waiter
+// may need to block for a long time.
+// The zero value for an Event is unset.
+// An Event must not be copied after first use.
+
+// Event 是一个一次性的信号，它只能被设置一次，任意数量的 goroutine 都可以在不阻塞
+// 的情况下观察它。Signal 以“释放”语义发布该事件，IsSet 以“获取”语义观察它，因此
+// 在 Signal 之前完成的写入，对于看到 IsSet 返回 true 的 goroutine 都是可见的。
+// Event 适合作为热路径上的廉价标志；若等待方可能需要长时间阻塞，请使用信道。
+// Event 的零值为未设置状态。Event 在首次使用后不能被复制。
+type Event struct {
+}
+
+// A Flag is a boolean that can be claimed by exactly one caller at a time.
+// Unlike sync.Once, a Flag does not run a function: the winner of TrySet
+// learns that it won and decides what to do, and the Flag may be Reset so
+// that a failed attempt can be retried by the next caller.
+// The zero value for a Flag is clear.
+// A Flag must not be copied after first use.
+
+// Flag 是一个每次只能被一个调用者获取的布尔值。与 sync.Once 不同，Flag 不会运行函数
+// ：TrySet 的胜出者会得知自己胜出并自行决定要做什么，并且 Flag 可以被 Reset，以便
+// 下一个调用者重试失败的操作。Flag 的零值为清除状态。Flag 在首次使用后不能被复制。
+type Flag struct {
+}
+
+// An Int64Handle provides atomic operations on an 8-byte aligned int64 stored
+// inside a View. Handles are obtained from View.Int64At.
+
+// Int64Handle 为存储在 View 中的、8 字节对齐的 int64 提供原子操作。Handle 通过
+// View.Int64At 获得。
+type Int64Handle struct {
+}
+
+// A Map is a concurrent map optimized for the read-mostly case. Loads of
+// keys that are already present read an immutable snapshot through an
+// atomic pointer and take no lock; stores go to a separate locked map that
+// is promoted to a new snapshot once enough loads have missed the current
+// one. Keys must be comparable.
+// The zero value for a Map is an empty map.
+// A Map must not be copied after first use.
+
+// Map 是一个针对读多写少场景优化的并发映射。对已存在键的载入操作会通过原子指针读取
+// 一个不可变的快照，无需加锁；存储操作写入一个单独的加锁映射，当足够多的载入未能在
+// 当前快照中命中时，该映射会被提升为新的快照。键必须是可比较的。Map 的零值为空映射
+// 。Map 在首次使用后不能被复制。
+type Map struct {
+}
+
+// A Queue is a lock-free, unbounded first-in first-out queue (the
+// Michael-Scott queue) that is safe for concurrent use by multiple
+// goroutines. The zero value for a Queue is an empty queue.
+// A Queue must not be copied after first use.
+
+// Queue 是一个无锁、无界的先进先出队列（Michael-Scott 队列），可安全地被多个
+// goroutine 并发使用。Queue 的零值为空队列。Queue 在首次使用后不能被复制。
+type Queue struct {
+}
+
+// An Rc is a reference-counted handle to a shared value. Each owner holds
+// one reference; Clone adds a reference and Release drops one. When the last
+// reference is released, the finalizer given to NewRc is called with the
+// value. All writes made by owners before their Release happen before the
+// finalizer runs.
+
+// Rc 是指向共享值的引用计数 handle。每个拥有者持有一个引用；Clone 增加一个引用，
+// Release 释放一个引用。当最后一个引用被释放时，会以该值调用传给 NewRc 的终结函数。
+// 所有拥有者在其 Release 之前完成的写入，都发生在终结函数运行之前。
+type Rc struct {
+}
+
+// A SeqLock is a sequence lock for data that is read often and written
+// rarely. Readers take no lock: they copy the data between RBegin and
+// RRetry and start over if RRetry reports that a write overlapped the copy.
+// A copy for which RRetry returned true may be torn and must be discarded
+// without acting on it. Writers are serialized by Lock and Unlock.
+//
+// A typical read loop is:
+//
+// 	for {
+// 		seq := l.RBegin()
+// 		c := data
+// 		if !l.RRetry(seq) {
+// 			return c
+// 		}
+// 	}
+//
+// The zero value for a SeqLock is unlocked.
+// A SeqLock must not be copied after first use.
+
+// SeqLock 是用于读多写少数据的顺序锁。读取者无需加锁：它们在 RBegin 和 RRetry 之间复
+// 制数据，若 RRetry 报告有写入与复制过程重叠，则重新开始。RRetry 返回 true 时得到的
+// 副本可能是不完整的，必须丢弃且不能据此采取任何行动。写入者通过 Lock 和 Unlock 进行
+// 串行化。
+//
+// 典型的读取循环如下：
+//
+// 	for {
+// 		seq := l.RBegin()
+// 		c := data
+// 		if !l.RRetry(seq) {
+// 			return c
+// 		}
+// 	}
+//
+// SeqLock 的零值为未锁定状态。SeqLock 在首次使用后不能被复制。
+type SeqLock struct {
+}
+
+// A Stack is a lock-free last-in first-out stack (the Treiber stack) that is
+// safe for concurrent use by multiple goroutines. Nodes are never reused
+// while reachable, so the compare-and-swap on the top of the stack is not
+// subject to the ABA problem. The zero value for a Stack is an empty stack.
+// A Stack must not be copied after first use.
+
+// Stack 是一个无锁的后进先出栈（Treiber 栈），可安全地被多个 goroutine 并发使用
+// 。节点在仍可访问时不会被重用，因此对栈顶的“比较并交换”操作不会受到 ABA 问题
+// 的影响。Stack 的零值为空栈。Stack 在首次使用后不能被复制。
+type Stack struct {
+}
 
 // A Value provides an atomic load and store of a consistently typed value.
 // Values can be created as part of other data structures.
@@ -80,6 +220,50 @@ import "unsafe"
 type Value struct {
 }
 
+// A View provides atomic access to values stored at fixed offsets within a
+// byte slice, such as a memory-mapped region shared with another process.
+// The values are accessed in the machine's native byte order.
+
+// View 为存储在字节切片中固定偏移处的值提供原子访问，例如与另一个进程共享的内存映射
+// 区域。这些值按照机器的本地字节序访问。
+type View struct {
+}
+
+// AddAndGetInt32 atomically adds delta to *addr and returns both the value
+// of *addr before the addition and the resulting value.
+
+// AddAndGetInt32 原子性地将 delta 加到 *addr 上，并同时返回相加之前的 *addr 值和相加后
+// 的结果值。
+func AddAndGetInt32(addr *int32, delta int32) (old, new int32)
+
+// AddAndGetInt64 atomically adds delta to *addr and returns both the value
+// of *addr before the addition and the resulting value.
+
+// AddAndGetInt64 原子性地将 delta 加到 *addr 上，并同时返回相加之前的 *addr 值和相加后
+// 的结果值。
+func AddAndGetInt64(addr *int64, delta int64) (old, new int64)
+
+// AddAndGetUint32 atomically adds delta to *addr and returns both the value
+// of *addr before the addition and the resulting value.
+
+// AddAndGetUint32 原子性地将 delta 加到 *addr 上，并同时返回相加之前的 *addr 值和相加后
+// 的结果值。
+func AddAndGetUint32(addr *uint32, delta uint32) (old, new uint32)
+
+// AddAndGetUint64 atomically adds delta to *addr and returns both the value
+// of *addr before the addition and the resulting value.
+
+// AddAndGetUint64 原子性地将 delta 加到 *addr 上，并同时返回相加之前的 *addr 值和相加后
+// 的结果值。
+func AddAndGetUint64(addr *uint64, delta uint64) (old, new uint64)
+
+// AddAndGetUintptr atomically adds delta to *addr and returns both the value
+// of *addr before the addition and the resulting value.
+
+// AddAndGetUintptr 原子性地将 delta 加到 *addr 上，并同时返回相加之前的 *addr 值和相加后
+// 的结果值。
+func AddAndGetUintptr(addr *uintptr, delta uintptr) (old, new uintptr)
+
 // AddInt32 atomically adds delta to *addr and returns the new value.
 
 // AddInt32 自动将 delta 加上 *addr 并返回新值。
@@ -137,6 +321,15 @@ func CompareAndSwapPointer(addr *unsafe.Pointer, old, new unsafe.Pointer) (swapp
 // CompareAndSwapUint32 为一个 uint32 类型的值执行“比较并交换”操作。
 func CompareAndSwapUint32(addr *uint32, old, new uint32) (swapped bool)
 
+// CompareAndSwapUint32Pair executes the compare-and-swap operation for a pair
+// of uint32 values packed into the uint64 at addr, as by StoreUint32Pair.
+// addr must be 8-byte aligned, as described for StoreUint32Pair.
+
+// CompareAndSwapUint32Pair 为按 StoreUint32Pair 方式打包到 addr 处 uint64 中的一对
+// uint32 值执行“比较并交换”操作。
+// addr 必须是 8 字节对齐的，详见 StoreUint32Pair。
+func CompareAndSwapUint32Pair(addr *uint64, oldLo, oldHi, newLo, newHi uint32) (swapped bool)
+
 // CompareAndSwapUint64 executes the compare-and-swap operation for a uint64
 // value.
 
@@ -169,6 +362,14 @@ func LoadPointer(addr *unsafe.Pointer) (val unsafe.Pointer)
 // LoadUint32 自动载入 *addr。
 func LoadUint32(addr *uint32) (val uint32)
 
+// LoadUint32Pair atomically loads the pair of uint32 values packed into *addr
+// by StoreUint32Pair. addr must be 8-byte aligned, as described for
+// StoreUint32Pair.
+
+// LoadUint32Pair 原子性地载入由 StoreUint32Pair 打包到 *addr 中的一对 uint32 值。
+// addr 必须是 8 字节对齐的，详见 StoreUint32Pair。
+func LoadUint32Pair(addr *uint64) (lo, hi uint32)
+
 // LoadUint64 atomically loads *addr.
 
 // LoadUint64 自动载入 *addr。
@@ -179,6 +380,30 @@ func LoadUint64(addr *uint64) (val uint64)
 // LoadUintptr 自动载入 *addr。
 func LoadUintptr(addr *uintptr) (val uintptr)
 
+// NewRc returns an Rc holding v with a reference count of one. finalizer,
+// if non-nil, is called with v when the count drops to zero.
+
+// NewRc 返回一个持有 v 且引用计数为一的 Rc。当计数降为零时，若 finalizer 不为 nil，
+// 则会以 v 调用它。
+func NewRc(v interface{}, finalizer func(interface{})) *Rc
+
+// NewView returns a View of b. The View refers to b directly, so b must remain
+// valid for as long as the View is used.
+
+// NewView 返回 b 的 View。View 直接引用 b，因此在使用 View 期间 b 必须保持有效。
+func NewView(b []byte) *View
+
+// SpinUntil calls cond repeatedly until it returns true or cond has been
+// called maxSpins times, issuing the processor's spin-wait hint (such as
+// PAUSE on x86) between calls. It reports whether cond returned true. It
+// never yields to the scheduler, so it is only suitable for waits that are
+// expected to be very short.
+
+// SpinUntil 反复调用 cond，直到其返回 true 或已被调用 maxSpins 次，在每次调用之间发
+// 出处理器的自旋等待提示（例如 x86 上的 PAUSE 指令）。它报告 cond 是否返回了 true。
+// 它永远不会让出给调度器，因此只适用于预计非常短暂的等待。
+func SpinUntil(cond func() bool, maxSpins int) bool
+
 // StoreInt32 atomically stores val into *addr.
 
 // StoreInt32 自动将 val 存储到 *addr 中。
@@ -199,6 +424,24 @@ func StorePointer(addr *unsafe.Pointer, val unsafe.Pointer)
 // StoreUint32 自动将 val 存储到 *addr 中。
 func StoreUint32(addr *uint32, val uint32)
 
+// StoreUint32Pair atomically stores lo and hi into *addr, lo in the low 32
+// bits and hi in the high 32 bits, so that both halves of a small struct
+// such as struct{ Lo, Hi uint32 } are always read and written together.
+//
+// The pair occupies 64 bits, which is larger than a machine word on 386,
+// ARM and 32-bit MIPS. On those platforms addr must be 8-byte aligned, as
+// for the other 64-bit functions in this package; otherwise the operation
+// faults. The first word of an allocated struct, array or slice can be
+// relied upon to be 64-bit aligned.
+
+// StoreUint32Pair 原子性地将 lo 和 hi 存储到 *addr 中，lo 位于低 32 位，hi 位于高
+// 32 位，从而使诸如 struct{ Lo, Hi uint32 } 这样的小结构体的两半总是被一起读写。
+//
+// 这一对值占用 64 位，在 386、ARM 和 32 位 MIPS 上大于一个机器字。在这些平台上，
+// addr 必须是 8 字节对齐的，与本包中其他 64 位函数的要求相同，否则该操作会出错。分
+// 配的结构体、数组或切片的第一个字可以保证是 64 位对齐的。
+func StoreUint32Pair(addr *uint64, lo, hi uint32)
+
 // StoreUint64 atomically stores val into *addr.
 
 // StoreUint64 自动将 val 存储到 *addr 中。
@@ -233,6 +476,15 @@ func SwapPointer(addr *unsafe.Pointer, new unsafe.Pointer) (old unsafe.Pointer)
 // SwapUint32 自动将 new 存储到 *addr 中并返回上一个 *addr 值。
 func SwapUint32(addr *uint32, new uint32) (old uint32)
 
+// SwapUint32Pair atomically stores newLo and newHi into *addr, as by
+// StoreUint32Pair, and returns the previous pair.
+// addr must be 8-byte aligned, as described for StoreUint32Pair.
+
+// SwapUint32Pair 按 StoreUint32Pair 的方式原子性地将 newLo 和 newHi 存储到 *addr 中
+// ，并返回之前的一对值。
+// addr 必须是 8 字节对齐的，详见 StoreUint32Pair。
+func SwapUint32Pair(addr *uint64, newLo, newHi uint32) (oldLo, oldHi uint32)
+
 // SwapUint64 atomically stores new into *addr and returns the previous *addr
 // value.
 
@@ -245,6 +497,174 @@ func SwapUint64(addr *uint64, new uint64) (old uint64)
 // SwapUintptr 自动将 new 存储到 *addr 中并返回上一个 *addr 值。
 func SwapUintptr(addr *uintptr, new uintptr) (old uintptr)
 
+// CompareAndSwap executes the compare-and-swap operation for d.
+
+// CompareAndSwap 为 d 执行“比较并交换”操作。
+func (d *Duration) CompareAndSwap(old, new time.Duration) (swapped bool)
+
+// Load atomically loads d.
+
+// Load 原子性地载入 d。
+func (d *Duration) Load() time.Duration
+
+// Store atomically stores val into d.
+
+// Store 原子性地将 val 存储到 d 中。
+func (d *Duration) Store(val time.Duration)
+
+// Swap atomically stores new into d and returns the previous value.
+
+// Swap 原子性地将 new 存储到 d 中并返回之前的值。
+func (d *Duration) Swap(new time.Duration) (old time.Duration)
+
+// IsSet reports whether Signal has been called.
+
+// IsSet 报告 Signal 是否已被调用。
+func (e *Event) IsSet() bool
+
+// Signal sets the event. Calling Signal more than once has no further effect.
+
+// Signal 设置该事件。多次调用 Signal 不会产生额外的效果。
+func (e *Event) Signal()
+
+// Wait spins, yielding the processor between checks, until the event is set.
+// It is intended for short waits only; it never parks the goroutine.
+
+// Wait 会自旋等待直到该事件被设置，在每次检查之间让出处理器。它只适用于短暂的等待
+// ，并不会将 goroutine 挂起。
+func (e *Event) Wait()
+
+// Reset clears the flag so that the next call to TrySet succeeds. Writes
+// made before Reset are visible to the goroutine whose TrySet then succeeds.
+
+// Reset 清除该标志，使下一次 TrySet 调用成功。在 Reset 之前完成的写入对随后
+// TrySet 成功的 goroutine 可见。
+func (f *Flag) Reset()
+
+// TrySet sets the flag and reports whether this call changed it from clear to
+// set. Among concurrent callers, exactly one observes true.
+
+// TrySet 设置该标志，并报告此次调用是否将其从清除状态变为设置状态。在并发调用者中
+// ，恰好有一个会得到 true。
+func (f *Flag) TrySet() bool
+
+// Add atomically adds delta to the int64 and returns the new value.
+
+// Add 原子性地将 delta 加到该 int64 上并返回新值。
+func (h *Int64Handle) Add(delta int64) (new int64)
+
+// CompareAndSwap executes the compare-and-swap operation for the int64.
+
+// CompareAndSwap 为该 int64 执行“比较并交换”操作。
+func (h *Int64Handle) CompareAndSwap(old, new int64) (swapped bool)
+
+// Load atomically loads the int64.
+
+// Load 原子性地载入该 int64。
+func (h *Int64Handle) Load() (val int64)
+
+// Store atomically stores val into the int64.
+
+// Store 原子性地将 val 存储到该 int64 中。
+func (h *Int64Handle) Store(val int64)
+
+// Delete deletes the value for key.
+
+// Delete 删除 key 对应的值。
+func (m *Map) Delete(key interface{})
+
+// Load returns the value stored in the map for key, or nil if there is none.
+// The ok result reports whether a value was found.
+
+// Load 返回映射中 key 对应的值，若不存在则返回 nil。ok 返回值报告是否找到了该值。
+func (m *Map) Load(key interface{}) (value interface{}, ok bool)
+
+// LoadOrStore returns the existing value for key if present. Otherwise, it
+// stores and returns value. The loaded result is true if the value was
+// loaded, false if stored.
+
+// LoadOrStore 在 key 存在时返回其已有的值。否则，它会存储并返回 value。若值是载入的
+// ，loaded 返回值为 true；若是存储的，则为 false。
+func (m *Map) LoadOrStore(key, value interface{}) (actual interface{}, loaded bool)
+
+// Range calls f sequentially for each key and value in the map, stopping if
+// f returns false. Range does not block other operations on the map, and
+// does not necessarily observe stores made while it runs.
+
+// Range 依次对映射中的每个键和值调用 f，当 f 返回 false 时停止。Range 不会阻塞对该
+// 映射的其他操作，也不一定能观察到其运行期间的存储操作。
+func (m *Map) Range(f func(key, value interface{}) bool)
+
+// Store sets the value for key.
+
+// Store 设置 key 对应的值。
+func (m *Map) Store(key, value interface{})
+
+// Dequeue removes and returns the value at the head of the queue. The ok
+// result reports whether the queue was non-empty.
+
+// Dequeue 移除并返回队列头部的值。ok 返回值报告队列是否非空。
+func (q *Queue) Dequeue() (x interface{}, ok bool)
+
+// Enqueue adds x to the tail of the queue.
+
+// Enqueue 将 x 添加到队列尾部。
+func (q *Queue) Enqueue(x interface{})
+
+// Clone adds a reference and returns r. It panics if the count has already
+// dropped to zero.
+
+// Clone 增加一个引用并返回 r。如果计数已经降为零，则会引发 panic。
+func (r *Rc) Clone() *Rc
+
+// Release drops a reference, calling the finalizer if it was the last one.
+// It panics if the count has already dropped to zero.
+
+// Release 释放一个引用，若这是最后一个引用，则调用终结函数。如果计数已经降为零，则会
+// 引发 panic。
+func (r *Rc) Release()
+
+// Value returns the shared value. It must not be used after the caller's
+// reference has been released.
+
+// Value 返回共享的值。在调用者的引用被释放之后，不能再使用它。
+func (r *Rc) Value() interface{}
+
+// Lock locks l for writing, waiting for any other writer to finish.
+
+// Lock 为写入锁定 l，并等待其他写入者完成。
+func (l *SeqLock) Lock()
+
+// RBegin starts a read and returns the sequence number to pass to RRetry.
+// It waits while a write is in progress.
+
+// RBegin 开始一次读取，并返回需要传给 RRetry 的序列号。若有写入正在进行，它会等待。
+func (l *SeqLock) RBegin() uint32
+
+// RRetry reports whether a write has started since the RBegin call that
+// returned seq, in which case the data read must be discarded and the read
+// retried.
+
+// RRetry 报告自返回 seq 的 RBegin 调用以来是否有写入开始，若是，则读取的数据必须被丢
+// 弃并重新读取。
+func (l *SeqLock) RRetry(seq uint32) bool
+
+// Unlock ends the write started by Lock.
+
+// Unlock 结束由 Lock 开始的写入。
+func (l *SeqLock) Unlock()
+
+// Pop removes and returns the value at the top of the stack. The ok result
+// reports whether the stack was non-empty.
+
+// Pop 移除并返回栈顶的值。ok 返回值报告栈是否非空。
+func (s *Stack) Pop() (x interface{}, ok bool)
+
+// Push adds x to the top of the stack.
+
+// Push 将 x 压入栈顶。
+func (s *Stack) Push(x interface{})
+
 // Load returns the value set by the most recent Store.
 // It returns nil if there has been no call to Store for this Value.
 
@@ -257,3 +677,10 @@ func (v *Value) Load() (x interface{})
 // panics, as does Store(nil).
 func (v *Value) Store(x interface{})
 
+// Int64At returns a handle for the int64 stored at the given byte offset in
+// the view. It panics if the 8 bytes at offset are not within the view or if
+// their address is not 8-byte aligned.
+
+// Int64At 返回视图中给定字节偏移处的 int64 的 handle。若 offset 处的 8 个字节不在
+// 视图范围内，或其地址不是 8 字节对齐的，则会引发 panic。
+func (v *View) Int64At(offset int) *Int64Handle